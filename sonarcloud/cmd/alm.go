@@ -0,0 +1,182 @@
+package sonarcloud
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+// validRepository GitHub org/repo
+var validRepository = regexp.MustCompile(`^[A-Za-z0-9-]+/[A-Za-z0-9._-]+$`)
+
+// GitHubBinding binds a project to its GitHub repository so pull
+// requests are decorated
+type GitHubBinding struct {
+	// ALMSetting (required) key of the GitHub ALM setting
+	ALMSetting string
+
+	// Repository (required) org/repo
+	Repository string
+
+	// SummaryComment post a summary comment on pull requests
+	SummaryComment bool
+
+	// Monorepo (optional) the repository holds several projects
+	Monorepo bool
+}
+
+// validate check the fields SonarCloud requires
+func (b GitHubBinding) validate() error {
+	if b.ALMSetting == "" {
+		return errors.New("alm setting is required")
+	}
+
+	if !validRepository.MatchString(b.Repository) {
+		return errors.New("repository must be org/repo: " + b.Repository)
+	}
+
+	return nil
+}
+
+// ALMSettingListResponse for a GET / alm_settings/list
+type ALMSettingListResponse struct {
+	ALMSettings []ALMSettingObject `json:"almSettings"`
+}
+
+// ALMSettingObject an ALM integration
+type ALMSettingObject struct {
+	// Key for access this object
+	Key string `json:"key"`
+
+	// ALM github, gitlab, bitbucket or azure
+	ALM string `json:"alm"`
+
+	// URL of the ALM
+	URL string `json:"url,omitempty"`
+}
+
+// ALMBindingResponse for a GET / alm_settings/get_binding
+type ALMBindingResponse struct {
+	// Key of the ALM setting
+	Key string `json:"key"`
+
+	// ALM github, gitlab, bitbucket or azure
+	ALM string `json:"alm"`
+
+	// Repository org/repo
+	Repository string `json:"repository"`
+
+	// URL of the ALM
+	URL string `json:"url,omitempty"`
+
+	// SummaryCommentEnabled true if a summary comment is posted
+	SummaryCommentEnabled bool `json:"summaryCommentEnabled"`
+
+	// Monorepo true if the repository holds several projects
+	Monorepo bool `json:"monorepo"`
+}
+
+// GetALMSettings list the ALM integrations available to a project
+//   example: GetALMSettings(projectKey)
+//   Unmarshal the response into an ALMSettingListResponse
+func (c *SonarCloudClient) GetALMSettings(project string) (*http.Response, error) {
+	params := url.Values{}
+	setIfNotEmpty(params, "project", project)
+
+	return c.get(ALMSettingList, params)
+}
+
+// SetGitHubBinding bind project to a GitHub repository
+//   example: SetGitHubBinding(projectKey, GitHubBinding{ALMSetting: "github",
+//		Repository: "acme/payments", SummaryComment: true})
+func (c *SonarCloudClient) SetGitHubBinding(project string, b GitHubBinding) (*http.Response, error) {
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+
+	data := url.Values{}
+	data.Set("project", project)
+	data.Set("almSetting", b.ALMSetting)
+	data.Set("repository", b.Repository)
+	data.Set("summaryCommentEnabled", strconv.FormatBool(b.SummaryComment))
+	data.Set("monorepo", strconv.FormatBool(b.Monorepo))
+
+	return c.post(ALMSetGitHubBinding, data)
+}
+
+// GetBinding read the ALM binding of a project
+//   example: GetBinding(projectKey)
+//   Unmarshal the response into an ALMBindingResponse
+func (c *SonarCloudClient) GetBinding(project string) (*http.Response, error) {
+	params := url.Values{}
+	params.Set("project", project)
+
+	return c.get(ALMGetBinding, params)
+}
+
+// DeleteBinding remove the ALM binding of a project
+//   example: DeleteBinding(projectKey)
+func (c *SonarCloudClient) DeleteBinding(project string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("project", project)
+
+	return c.post(ALMDeleteBinding, data)
+}
+
+// VerifyGitHubBinding check project is bound to b.Repository with
+// the expected options and that SonarCloud can reach it
+//   example: VerifyGitHubBinding(projectKey, binding)
+func (c *SonarCloudClient) VerifyGitHubBinding(project string, b GitHubBinding) error {
+	rsp, err := c.GetBinding(project)
+	if err != nil {
+		return err
+	}
+
+	var got ALMBindingResponse
+	if err = decodeResponse(rsp, &got); err != nil {
+		return err
+	}
+
+	if got.Repository != b.Repository || got.Key != b.ALMSetting {
+		return fmt.Errorf("project %s is bound to %s/%s expected %s/%s",
+			project, got.Key, got.Repository, b.ALMSetting, b.Repository)
+	}
+
+	if got.SummaryCommentEnabled != b.SummaryComment {
+		return fmt.Errorf("project %s summary comment is %v expected %v",
+			project, got.SummaryCommentEnabled, b.SummaryComment)
+	}
+
+	params := url.Values{}
+	params.Set("project", project)
+
+	rsp, err = c.get(ALMValidateBinding, params)
+	if err != nil {
+		return fmt.Errorf("project %s binding is invalid: %v", project, err)
+	}
+	rsp.Body.Close()
+
+	return nil
+}
+
+// CreateBoundProject create a project and bind it to its GitHub
+// repository
+//   example: CreateBoundProject(NewProject{...}, GitHubBinding{...})
+//   The binding is validated before the project is created
+//   If the binding fails the project is kept and the error returned
+func (c *SonarCloudClient) CreateBoundProject(p NewProject, b GitHubBinding) (*http.Response, error) {
+	if err := b.validate(); err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.CreateProject(p)
+	if err != nil {
+		return rsp, err
+	}
+	rsp.Body.Close()
+
+	return c.SetGitHubBinding(KeyPrefix+p.Project, b)
+}
@@ -0,0 +1,92 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+)
+
+// TestCreateBoundProject create then bind
+func TestCreateBoundProject(t *testing.T) {
+	var calls []string
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.URL.Path)
+		switch r.URL.Path {
+		case ProjectCreate:
+			w.Write([]byte(`{"project": {"key": "PavedRoad_test123", "name": "Test project 123"}}`))
+		case ALMSetGitHubBinding:
+			if r.FormValue("project") != KeyPrefix+projectKey || r.FormValue("repository") != "acme/payments" ||
+				r.FormValue("summaryCommentEnabled") != "true" {
+				t.Errorf("Unexpected form %v\n", r.Form)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	defer srv.Close()
+
+	p := NewProject{
+		Organization: orgname,
+		Name:         projectName,
+		Project:      projectKey,
+		Visibility:   visibility,
+	}
+	b := GitHubBinding{
+		ALMSetting:     "github",
+		Repository:     "acme/payments",
+		SummaryComment: true,
+	}
+
+	rsp, err := c.CreateBoundProject(p, b)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	checkResponseCode(t, http.StatusNoContent, rsp.StatusCode)
+
+	if len(calls) != 2 || calls[0] != ProjectCreate {
+		t.Errorf("Unexpected calls %v\n", calls)
+	}
+
+	b.Repository = "payments"
+	if _, err = c.CreateBoundProject(p, b); err == nil {
+		t.Errorf("Expected error for invalid repository\n")
+	}
+
+	b.Repository = "acme/payments"
+	b.ALMSetting = ""
+	if _, err = c.CreateBoundProject(p, b); err == nil {
+		t.Errorf("Expected error for missing alm setting\n")
+	}
+
+	// Invalid bindings never create the project
+	if len(calls) != 2 {
+		t.Errorf("Unexpected calls %v\n", calls)
+	}
+}
+
+// TestVerifyGitHubBinding
+func TestVerifyGitHubBinding(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ALMGetBinding:
+			w.Write([]byte(`{"key": "github", "alm": "github", "repository": "acme/payments",
+			  "summaryCommentEnabled": true, "monorepo": false}`))
+		case ALMValidateBinding:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	defer srv.Close()
+
+	b := GitHubBinding{
+		ALMSetting:     "github",
+		Repository:     "acme/payments",
+		SummaryComment: true,
+	}
+
+	if err := c.VerifyGitHubBinding(projectKey, b); err != nil {
+		t.Errorf(testErrorMsg, err)
+	}
+
+	b.Repository = "acme/billing"
+	if err := c.VerifyGitHubBinding(projectKey, b); err == nil {
+		t.Errorf("Expected error for wrong repository\n")
+	}
+}
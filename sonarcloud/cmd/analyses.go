@@ -0,0 +1,143 @@
+package sonarcloud
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// AnalysisSearchOptions used to filter project analyses
+//   Project is required
+type AnalysisSearchOptions struct {
+	// Project is the SonarCloud Key
+	Project string
+
+	// Branch (optional) a long living branch
+	Branch string
+
+	// Category (optional) only analyses with an event of this category
+	Category string
+
+	// From (optional) date, YYYY-MM-DD, inclusive
+	From string
+
+	// To (optional) date, YYYY-MM-DD, inclusive
+	To string
+
+	// Page (optional) 1-based page number
+	Page int
+
+	// PageSize (optional) number of analyses per page
+	PageSize int
+}
+
+// AnalysisSearchResponse for a GET / search on project analyses
+type AnalysisSearchResponse struct {
+	// Paging object
+	Paging PagingObject `json:"paging"`
+
+	// Analyses list, most recent first
+	Analyses []AnalysisObject `json:"analyses"`
+}
+
+// AnalysisObject a project analysis
+type AnalysisObject struct {
+	// Key for access this object
+	Key string `json:"key"`
+
+	// Date and time of the analysis
+	Date string `json:"date"`
+
+	// ProjectVersion set with sonar.projectVersion
+	ProjectVersion string `json:"projectVersion,omitempty"`
+
+	// BuildString set with sonar.buildString
+	BuildString string `json:"buildString,omitempty"`
+
+	// Revision hash, same as ComponentsObject.Revision
+	Revision string `json:"revision,omitempty"`
+
+	// Events attached to the analysis
+	Events []AnalysisEvent `json:"events"`
+}
+
+// AnalysisEvent an event attached to an analysis
+type AnalysisEvent struct {
+	// Key for access this object
+	Key string `json:"key"`
+
+	// Analysis key the event belongs to
+	Analysis string `json:"analysis,omitempty"`
+
+	// Category EventVersion, EventOther, EventQualityGate ...
+	Category string `json:"category"`
+
+	// Name for display, e.g. 1.2.0
+	Name string `json:"name"`
+
+	// Description for display
+	Description string `json:"description,omitempty"`
+}
+
+// AnalysisEventResponse for create_event and update_event
+type AnalysisEventResponse struct {
+	Event AnalysisEvent `json:"event"`
+}
+
+// SearchAnalyses list project analyses and their events
+//   example: SearchAnalyses(AnalysisSearchOptions{Project: key, Category: EventVersion})
+//   Unmarshal the response into an AnalysisSearchResponse
+func (c *SonarCloudClient) SearchAnalyses(o AnalysisSearchOptions) (*http.Response, error) {
+	if o.Project == "" {
+		return nil, errors.New("project is required")
+	}
+
+	params := url.Values{}
+	params.Set("project", o.Project)
+	setIfNotEmpty(params, "branch", o.Branch)
+	setIfNotEmpty(params, "category", o.Category)
+	setIfNotEmpty(params, "from", o.From)
+	setIfNotEmpty(params, "to", o.To)
+	setIfNotZero(params, "p", o.Page)
+	setIfNotZero(params, "ps", o.PageSize)
+
+	return c.get(AnalysisSearch, params)
+}
+
+// CreateAnalysisEvent attach an event to analysis
+//   example: CreateAnalysisEvent(analysisKey, "1.2.0", EventVersion)
+//   category (optional) EventVersion or EventOther, defaults to EventOther
+//   An analysis can only have one EventVersion event
+//   Unmarshal the response into an AnalysisEventResponse
+func (c *SonarCloudClient) CreateAnalysisEvent(analysis, name, category string) (*http.Response, error) {
+	if category != "" && category != EventVersion && category != EventOther {
+		return nil, errors.New("invalid category: " + category)
+	}
+
+	data := url.Values{}
+	data.Set("analysis", analysis)
+	data.Set("name", name)
+	setIfNotEmpty(data, "category", category)
+
+	return c.post(AnalysisCreateEvent, data)
+}
+
+// UpdateAnalysisEvent rename event
+//   example: UpdateAnalysisEvent(eventKey, "1.2.1")
+//   Unmarshal the response into an AnalysisEventResponse
+func (c *SonarCloudClient) UpdateAnalysisEvent(event, name string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("event", event)
+	data.Set("name", name)
+
+	return c.post(AnalysisUpdateEvent, data)
+}
+
+// DeleteAnalysisEvent delete event
+//   example: DeleteAnalysisEvent(eventKey)
+func (c *SonarCloudClient) DeleteAnalysisEvent(event string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("event", event)
+
+	return c.post(AnalysisDeleteEvent, data)
+}
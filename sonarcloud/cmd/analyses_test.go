@@ -0,0 +1,84 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+)
+
+const analysisSearchJSON = `{
+  "paging": {"pageIndex": 1, "pageSize": 100, "total": 2},
+  "analyses": [
+    {
+      "key": "AXA2",
+      "date": "2020-08-02T10:00:00+0000",
+      "projectVersion": "1.2.0",
+      "revision": "c739069ec7105e01303e8b3065a81141aad9f129",
+      "events": [{"key": "AXE1", "category": "VERSION", "name": "1.2.0"}]
+    },
+    {
+      "key": "AXA1",
+      "date": "2020-08-01T10:00:00+0000",
+      "revision": "0e4a5f5b2c3a0f0c5d1d2f0e3a0b0c0d0e0f0a0b",
+      "events": []
+    }
+  ]
+}`
+
+// TestSearchAnalyses
+func TestSearchAnalyses(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("project") != projectKey || q.Get("category") != EventVersion {
+			t.Errorf("Unexpected query %v\n", q)
+		}
+		w.Write([]byte(analysisSearchJSON))
+	})
+	defer srv.Close()
+
+	rsp, err := c.SearchAnalyses(AnalysisSearchOptions{
+		Project:  projectKey,
+		Category: EventVersion,
+	})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var as AnalysisSearchResponse
+	if err = decodeResponse(rsp, &as); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	if len(as.Analyses) != 2 || as.Analyses[0].Events[0].Name != "1.2.0" ||
+		as.Analyses[1].Revision == "" {
+		t.Errorf("Unexpected analyses %v\n", as.Analyses)
+	}
+}
+
+// TestCreateAnalysisEvent
+func TestCreateAnalysisEvent(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("analysis") != "AXA2" || r.FormValue("category") != EventVersion {
+			t.Errorf("Unexpected form %v\n", r.Form)
+		}
+		w.Write([]byte(`{"event": {"analysis": "AXA2", "key": "AXE1", "category": "VERSION", "name": "1.2.0"}}`))
+	})
+	defer srv.Close()
+
+	rsp, err := c.CreateAnalysisEvent("AXA2", "1.2.0", EventVersion)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var ev AnalysisEventResponse
+	if err = decodeResponse(rsp, &ev); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	if ev.Event.Key != "AXE1" {
+		t.Errorf(testErrorMsgValue, "AXE1", ev.Event.Key)
+	}
+
+	if _, err = c.CreateAnalysisEvent("AXA2", "x", EventQualityGate); err == nil {
+		t.Errorf("Expected error for invalid category\n")
+	}
+}
@@ -0,0 +1,115 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// BadgeBlock describes the badge header of a service README
+type BadgeBlock struct {
+	// Options (required) project and optional branch or pull request
+	Options BadgeOptions
+
+	// Format MarkDown or HTML, defaults to MarkDown
+	Format int
+
+	// Metrics (optional) badges after the quality gate in this order,
+	// defaults to every metric but AlertStatus
+	Metrics []Metric
+
+	// Verify GET each badge URL without credentials, as a README
+	// reader would, and fail if it does not resolve
+	Verify bool
+}
+
+// DefaultBlockMetrics the metrics of a badge block when none are given
+//   AlertStatus is left out as the quality gate badge comes first
+func DefaultBlockMetrics() []Metric {
+	var m []Metric
+	for _, v := range AllMetrics() {
+		if v != AlertStatus {
+			m = append(m, v)
+		}
+	}
+	return m
+}
+
+// GetBadgeBlock return the quality gate badge followed by one badge per
+// metric, one per line
+//   example: GetBadgeBlock(BadgeBlock{Options: BadgeOptions{Project: projectKey},
+//		Metrics: []Metric{Coverage, Bugs}, Verify: true})
+//   Duplicate metrics are only rendered once
+func (c *SonarCloudClient) GetBadgeBlock(b BadgeBlock) (string, error) {
+	metrics := b.Metrics
+	if len(metrics) == 0 {
+		metrics = DefaultBlockMetrics()
+	}
+
+	for _, m := range metrics {
+		if !m.Valid() {
+			return "", fmt.Errorf("invalid metric %v", m)
+		}
+	}
+
+	img, err := c.QualityGateBadgeURL(b.Options)
+	if err != nil {
+		return "", err
+	}
+
+	gate, err := c.blockBadge(b, qualityGateAlt, img)
+	if err != nil {
+		return "", fmt.Errorf("quality gate badge: %v", err)
+	}
+	lines := []string{gate}
+
+	seen := make(map[Metric]bool)
+	for _, m := range metrics {
+		if seen[m] {
+			continue
+		}
+		seen[m] = true
+
+		if img, err = c.MetricBadgeURL(m, b.Options); err != nil {
+			return "", err
+		}
+
+		badge, err := c.blockBadge(b, m.Label(), img)
+		if err != nil {
+			return "", fmt.Errorf("%v badge: %v", m, err)
+		}
+		lines = append(lines, badge)
+	}
+
+	return strings.Join(lines, "\n") + "\n", nil
+}
+
+// blockBadge render img, fetching it first if b.Verify is set
+func (c *SonarCloudClient) blockBadge(b BadgeBlock, alt, img string) (string, error) {
+	if b.Verify {
+		rsp, err := c.Client.Get(img)
+		if err = checkBadge(rsp, err); err != nil {
+			return "", err
+		}
+	}
+
+	return renderBadge(b.Format, alt, img, c.DashboardURL(b.Options))
+}
+
+// checkBadge return an error unless rsp is an SVG badge
+func checkBadge(rsp *http.Response, err error) error {
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status %d", rsp.StatusCode)
+	}
+
+	if ct := rsp.Header.Get(contentType); ct != "" && !strings.HasPrefix(ct, "image/svg") {
+		return fmt.Errorf("unexpected content type %s", ct)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// TestGetBadgeBlock quality gate first then metrics in the given order
+func TestGetBadgeBlock(t *testing.T) {
+	project := "pavedroad-io_integrations"
+	c := publicClient(project)
+
+	block, err := c.GetBadgeBlock(BadgeBlock{
+		Options: BadgeOptions{Project: project},
+		Metrics: []Metric{Coverage, DuplicatedLinesDensity, Coverage},
+	})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(block, "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf(testErrorMsgValue, 3, len(lines))
+	}
+	if !strings.HasPrefix(lines[0], "[![Quality Gate](") {
+		t.Errorf("Expected quality gate first Got %v\n", lines[0])
+	}
+	if lines[1] != mdCoverage || lines[2] != mdDuplicated {
+		t.Errorf("Unexpected block %v\n", block)
+	}
+
+	block, err = c.GetBadgeBlock(BadgeBlock{Options: BadgeOptions{Project: project}, Format: HTML})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	if n := strings.Count(block, "<a href="); n != len(AllMetrics()) {
+		t.Errorf(testErrorMsgValue, len(AllMetrics()), n)
+	}
+	if strings.Contains(block, "alert_status") {
+		t.Errorf("Expected alert_status to be left out\n")
+	}
+}
+
+// TestGetBadgeBlockVerify a badge that doesn't resolve fails the block
+func TestGetBadgeBlockVerify(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch {
+		case r.URL.Path == ComponentShow:
+			visibility := "public"
+			if q.Get("component") == "private-svc" {
+				visibility = "private"
+			}
+			w.Write([]byte(`{"component": {"visibility": "` + visibility + `"}}`))
+		case r.URL.Path == BadgeToken:
+			w.Write([]byte(`{"token": "stale"}`))
+		case r.Header.Get("Authorization") != "":
+			t.Errorf("Expected an anonymous badge request %v\n", r.URL)
+			w.WriteHeader(http.StatusBadRequest)
+		case q.Get("project") == "private-svc" && q.Get("token") != "current":
+			w.WriteHeader(http.StatusForbidden)
+		case q.Get("metric") == "sqale_index":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors": [{"msg": "Metric 'sqale_index' not found"}]}`))
+		default:
+			w.Header().Set(contentType, "image/svg+xml")
+			w.Write([]byte("<svg></svg>"))
+		}
+	})
+	defer srv.Close()
+
+	o := BadgeOptions{Project: projectKey}
+	if _, err := c.GetBadgeBlock(BadgeBlock{Options: o, Metrics: []Metric{Bugs}, Verify: true}); err != nil {
+		t.Errorf(testErrorMsg, err)
+	}
+
+	_, err := c.GetBadgeBlock(BadgeBlock{Options: o, Metrics: []Metric{Bugs, SqaleIndex}, Verify: true})
+	if err == nil || !strings.HasPrefix(err.Error(), "sqale_index badge") {
+		t.Errorf("Expected sqale_index badge error Got %v\n", err)
+	}
+
+	// A wrong badge token is caught
+	o.Project = "private-svc"
+	if _, err = c.GetBadgeBlock(BadgeBlock{Options: o, Metrics: []Metric{Bugs}, Verify: true}); err == nil {
+		t.Errorf("Expected error for a wrong badge token\n")
+	}
+}
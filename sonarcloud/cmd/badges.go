@@ -0,0 +1,89 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+)
+
+// qualityGateAlt alt text of the quality gate badge
+const qualityGateAlt = "Quality Gate"
+
+// DashboardURL return the SonarCloud page of a project, branch or pull request
+//   example: DashboardURL(BadgeOptions{Project: projectKey, Branch: "release-1.2"})
+func (c *SonarCloudClient) DashboardURL(o BadgeOptions) string {
+	link := DefaultScheme + c.Host + Dashboard + "?" + fmt.Sprintf(ID, url.QueryEscape(o.Project))
+	if o.Branch != "" {
+		link += fmt.Sprintf("&"+Branch, url.QueryEscape(o.Branch))
+	}
+	if o.PullRequest != "" {
+		link += fmt.Sprintf("&"+PullRequest, url.QueryEscape(o.PullRequest))
+	}
+	return link
+}
+
+// MetricBadgeURL return the public URL of a metric badge
+//   example: MetricBadgeURL(Coverage, BadgeOptions{Project: projectKey})
+//   The badge token is added for private projects
+func (c *SonarCloudClient) MetricBadgeURL(metric Metric, o BadgeOptions) (string, error) {
+	if !metric.Valid() {
+		return "", fmt.Errorf("invalid metric %v", metric)
+	}
+
+	options, err := c.badgeQuery(o)
+	if err != nil {
+		return "", err
+	}
+
+	return DefaultScheme + c.Host + BadgeMetric + "?" + options + "&" + fmt.Sprintf(MetricParam, metric), nil
+}
+
+// QualityGateBadgeURL return the public URL of the quality gate badge
+//   example: QualityGateBadgeURL(BadgeOptions{Project: projectKey})
+//   The badge token is added for private projects
+func (c *SonarCloudClient) QualityGateBadgeURL(o BadgeOptions) (string, error) {
+	options, err := c.badgeQuery(o)
+	if err != nil {
+		return "", err
+	}
+
+	return DefaultScheme + c.Host + QualityGate + "?" + options, nil
+}
+
+// GetBadge return a metric badge as MarkDown, HTML or a Link
+//   example: GetBadge(MarkDown, Coverage, BadgeOptions{Project: projectKey})
+//   MarkDown and HTML badges link to the project dashboard
+func (c *SonarCloudClient) GetBadge(outputType int, metric Metric, o BadgeOptions) (string, error) {
+	img, err := c.MetricBadgeURL(metric, o)
+	if err != nil {
+		return "", err
+	}
+
+	return renderBadge(outputType, metric.Label(), img, c.DashboardURL(o))
+}
+
+// GetQualityGateBadge return the quality gate badge as MarkDown, HTML
+// or a Link
+//   example: GetQualityGateBadge(HTML, BadgeOptions{Project: projectKey})
+func (c *SonarCloudClient) GetQualityGateBadge(outputType int, o BadgeOptions) (string, error) {
+	img, err := c.QualityGateBadgeURL(o)
+	if err != nil {
+		return "", err
+	}
+
+	return renderBadge(outputType, qualityGateAlt, img, c.DashboardURL(o))
+}
+
+// renderBadge format a badge image linking to ref
+func renderBadge(outputType int, alt, img, ref string) (string, error) {
+	switch outputType {
+	case MarkDown:
+		return fmt.Sprintf("[![%s](%s)](%s)", alt, img, ref), nil
+	case HTML:
+		return fmt.Sprintf("<a href=\"%s\"><img src=\"%s\" alt=\"%s\"/></a>",
+			html.EscapeString(ref), html.EscapeString(img), html.EscapeString(alt)), nil
+	case Link:
+		return img, nil
+	}
+	return "", fmt.Errorf("invalid output type %d", outputType)
+}
@@ -0,0 +1,87 @@
+package sonarcloud
+
+import (
+	"strings"
+	"testing"
+)
+
+// Markdown from the SonarCloud project information page
+var mdCoverage = `[![Coverage](https://sonarcloud.io/api/project_badges/measure?project=pavedroad-io_integrations&metric=coverage)](https://sonarcloud.io/dashboard?id=pavedroad-io_integrations)`
+var mdDuplicated = `[![Duplicated Lines (%)](https://sonarcloud.io/api/project_badges/measure?project=pavedroad-io_integrations&metric=duplicated_lines_density)](https://sonarcloud.io/dashboard?id=pavedroad-io_integrations)`
+
+// HTML with the same layout as the fossa package
+var htmlBugs = `<a href="https://sonarcloud.io/dashboard?id=pavedroad-io_integrations&amp;branch=release-1.2"><img src="https://sonarcloud.io/api/project_badges/measure?project=pavedroad-io_integrations&amp;branch=release-1.2&amp;metric=bugs" alt="Bugs"/></a>`
+
+var linkGate = `https://sonarcloud.io/api/project_badges/quality_gate?project=pavedroad-io_integrations&pullRequest=42`
+
+type badgeItem struct {
+	format   int
+	metric   Metric
+	options  BadgeOptions
+	expected string
+}
+
+// publicClient a client that knows project is public
+func publicClient(project string) *SonarCloudClient {
+	c := &SonarCloudClient{Host: DefaultHost, badgeTokens: newBadgeTokenCache()}
+	c.badgeTokens.set(project, "")
+	return c
+}
+
+// TestGetBadge
+func TestGetBadge(t *testing.T) {
+	project := "pavedroad-io_integrations"
+	c := publicClient(project)
+
+	testCases := [...]badgeItem{
+		{MarkDown, Coverage, BadgeOptions{Project: project}, mdCoverage},
+		{MarkDown, DuplicatedLinesDensity, BadgeOptions{Project: project}, mdDuplicated},
+		{HTML, Bugs, BadgeOptions{Project: project, Branch: "release-1.2"}, htmlBugs},
+	}
+
+	for _, tc := range testCases {
+		got, err := c.GetBadge(tc.format, tc.metric, tc.options)
+		if err != nil {
+			t.Fatalf(testErrorMsg, err)
+		}
+		if got != tc.expected {
+			t.Errorf(testErrorMsgValue, tc.expected, got)
+		}
+	}
+
+	got, err := c.GetQualityGateBadge(Link, BadgeOptions{Project: project, PullRequest: "42"})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	if got != linkGate {
+		t.Errorf(testErrorMsgValue, linkGate, got)
+	}
+
+	if _, err = c.GetBadge(42, Bugs, BadgeOptions{Project: project}); err == nil {
+		t.Errorf("Expected error for invalid output type\n")
+	}
+	if _, err = c.GetBadge(Link, Metric(42), BadgeOptions{Project: project}); err == nil {
+		t.Errorf("Expected error for invalid metric\n")
+	}
+}
+
+// TestGetBadgePrivate badges of private projects carry the badge token
+// and never the API token
+func TestGetBadgePrivate(t *testing.T) {
+	calls := map[string]int{}
+	c, srv := newTestServer(t, badgeServer(t, calls))
+	defer srv.Close()
+
+	got, err := c.GetBadge(MarkDown, Coverage, BadgeOptions{Project: "private-svc"})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	if !strings.Contains(got, "token=badge123") || strings.Contains(got, c.Token) {
+		t.Errorf("Unexpected badge %v\n", got)
+	}
+
+	if calls[BadgeToken] != 1 {
+		t.Errorf("Unexpected calls %v\n", calls)
+	}
+}
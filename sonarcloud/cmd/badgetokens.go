@@ -0,0 +1,131 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+// BadgeTokenResponse for a GET / project_badges/token
+type BadgeTokenResponse struct {
+	Token string `json:"token"`
+}
+
+// ComponentShowResponse for a GET / components/show
+type ComponentShowResponse struct {
+	Component ComponentsObject `json:"component"`
+}
+
+// badgeTokenCache badge tokens by project, "" for public projects
+type badgeTokenCache struct {
+	mu     sync.Mutex
+	tokens map[string]string
+}
+
+func newBadgeTokenCache() *badgeTokenCache {
+	return &badgeTokenCache{tokens: make(map[string]string)}
+}
+
+func (b *badgeTokenCache) get(project string) (string, bool) {
+	if b == nil {
+		return "", false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t, ok := b.tokens[project]
+	return t, ok
+}
+
+func (b *badgeTokenCache) set(project, token string) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.tokens[project] = token
+}
+
+func (b *badgeTokenCache) delete(project string) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.tokens, project)
+}
+
+// GetBadgeToken read the badge token of a project
+//   example: GetBadgeToken(projectKey)
+//   Unmarshal the response into a BadgeTokenResponse
+func (c *SonarCloudClient) GetBadgeToken(project string) (*http.Response, error) {
+	params := url.Values{}
+	params.Set("project", project)
+
+	return c.get(BadgeToken, params)
+}
+
+// RenewBadgeToken replace the badge token of a project
+//   example: RenewBadgeToken(projectKey)
+//   Badges using the old token stop working
+func (c *SonarCloudClient) RenewBadgeToken(project string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("project", project)
+
+	c.badgeTokens.delete(project)
+
+	return c.post(BadgeRenewToken, data)
+}
+
+// BadgeToken return the token badge URLs need for project
+//   example: BadgeToken(projectKey)
+//   Public projects don't need one and "" is returned
+//   If the visibility lookup answers 403 or 404, e.g. the token lacks
+//   browse permission, the project is treated as public
+//   Any other error is returned so no badge is built without a token
+//   The result, including the public fallback, is cached by the client
+func (c *SonarCloudClient) BadgeToken(project string) (string, error) {
+	if t, ok := c.badgeTokens.get(project); ok {
+		return t, nil
+	}
+
+	params := url.Values{}
+	params.Set("component", project)
+
+	rsp, err := c.get(ComponentShow, params)
+	if err != nil {
+		if rsp != nil && (rsp.StatusCode == http.StatusForbidden ||
+			rsp.StatusCode == http.StatusNotFound) {
+			c.badgeTokens.set(project, "")
+			return "", nil
+		}
+		return "", err
+	}
+
+	var cs ComponentShowResponse
+	if err = decodeResponse(rsp, &cs); err != nil {
+		return "", err
+	}
+
+	token := ""
+	if cs.Component.Visibility == "private" {
+		if rsp, err = c.GetBadgeToken(project); err != nil {
+			return "", err
+		}
+
+		var bt BadgeTokenResponse
+		if err = decodeResponse(rsp, &bt); err != nil {
+			return "", err
+		}
+		token = bt.Token
+	}
+
+	c.badgeTokens.set(project, token)
+
+	return token, nil
+}
@@ -0,0 +1,131 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// badgeServer serves a public and a private project
+func badgeServer(t *testing.T, calls map[string]int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		calls[r.URL.Path]++
+		q := r.URL.Query()
+
+		switch r.URL.Path {
+		case ComponentShow:
+			visibility := "public"
+			if q.Get("component") == "private-svc" {
+				visibility = "private"
+			}
+			fmt.Fprintf(w, `{"component": {"key": %q, "visibility": %q}}`, q.Get("component"), visibility)
+		case BadgeToken:
+			w.Write([]byte(`{"token": "badge123"}`))
+		case BadgeMetric, QualityGate:
+			expected := ""
+			if q.Get("project") == "private-svc" {
+				expected = "badge123"
+			}
+			if q.Get("token") != expected {
+				t.Errorf(testErrorMsgValue, expected, q.Get("token"))
+			}
+			w.Write([]byte("<svg></svg>"))
+		default:
+			http.NotFound(w, r)
+		}
+	}
+}
+
+// TestBadgeToken private projects get a token, public ones don't
+func TestBadgeToken(t *testing.T) {
+	calls := map[string]int{}
+	c, srv := newTestServer(t, badgeServer(t, calls))
+	defer srv.Close()
+
+	for _, project := range []string{"private-svc", "public-svc"} {
+		for i := 0; i < 2; i++ {
+			rsp, err := c.GetMetric(Coverage, project, "")
+			if err != nil {
+				t.Fatalf(testErrorMsg, err)
+			}
+			checkResponseCode(t, http.StatusOK, rsp.StatusCode)
+
+			rsp, err = c.GetQualityGate(project)
+			if err != nil {
+				t.Fatalf(testErrorMsg, err)
+			}
+			checkResponseCode(t, http.StatusOK, rsp.StatusCode)
+		}
+	}
+
+	// Visibility and token are only read once per project
+	if calls[ComponentShow] != 2 || calls[BadgeToken] != 1 {
+		t.Errorf("Unexpected calls %v\n", calls)
+	}
+}
+
+// TestBadgeTokenFallback a forbidden visibility lookup gives a public
+// badge, other failures are errors
+func TestBadgeTokenFallback(t *testing.T) {
+	calls := 0
+	status := http.StatusForbidden
+	var tokens []string
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ComponentShow:
+			calls++
+			w.WriteHeader(status)
+			w.Write([]byte(`{"errors":[{"msg":"Insufficient privileges"}]}`))
+		default:
+			tokens = append(tokens, r.URL.Query().Get("token"))
+			w.Write([]byte("<svg></svg>"))
+		}
+	})
+	defer srv.Close()
+
+	// The fallback is cached
+	for i := 0; i < 2; i++ {
+		rsp, err := c.GetMetric(Bugs, "private-svc", "")
+		if err != nil {
+			t.Fatalf(testErrorMsg, err)
+		}
+		checkResponseCode(t, http.StatusOK, rsp.StatusCode)
+	}
+
+	if calls != 1 || len(tokens) != 2 || tokens[0] != "" || tokens[1] != "" {
+		t.Errorf("Unexpected calls %d tokens %q\n", calls, tokens)
+	}
+
+	status = http.StatusInternalServerError
+	if _, err := c.GetQualityGate("other-svc"); err == nil {
+		t.Errorf("Expected an error for status %d\n", status)
+	}
+	if len(tokens) != 2 {
+		t.Errorf("Expected no badge request Got %q\n", tokens)
+	}
+}
+
+// TestBadgeTokenEscaped tokens are query escaped in badge URLs
+func TestBadgeTokenEscaped(t *testing.T) {
+	var tokens []string
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ComponentShow:
+			w.Write([]byte(`{"component": {"key": "private-svc", "visibility": "private"}}`))
+		case BadgeToken:
+			w.Write([]byte(`{"token": "a+b&c"}`))
+		default:
+			tokens = append(tokens, r.URL.Query().Get("token"))
+			w.Write([]byte("<svg></svg>"))
+		}
+	})
+	defer srv.Close()
+
+	if _, err := c.GetQualityGate("private-svc"); err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	if len(tokens) != 1 || tokens[0] != "a+b&c" {
+		t.Errorf("Unexpected tokens %q\n", tokens)
+	}
+}
@@ -0,0 +1,265 @@
+package sonarcloud
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// Polling intervals used by WaitForAnalysis
+var (
+	waitInitialInterval = 1 * time.Second
+	waitMaxInterval     = 30 * time.Second
+)
+
+// CETaskResponse for a GET / ce/task
+type CETaskResponse struct {
+	Task CETaskObject `json:"task"`
+}
+
+// CEActivityResponse for a GET / ce/activity
+type CEActivityResponse struct {
+	// Paging object
+	Paging PagingObject `json:"paging"`
+
+	// Tasks list
+	Tasks []CETaskObject `json:"tasks"`
+}
+
+// CETaskObject a Compute Engine task
+type CETaskObject struct {
+	// ID for access this object
+	ID string `json:"id"`
+
+	// Type e.g. REPORT
+	Type string `json:"type"`
+
+	// Organization name
+	Organization string `json:"organization"`
+
+	// ComponentKey project the task belongs to
+	ComponentKey string `json:"componentKey"`
+
+	// ComponentName for display
+	ComponentName string `json:"componentName"`
+
+	// Status TaskPending, TaskInProgress, TaskSuccess,
+	// TaskFailed or TaskCanceled
+	Status string `json:"status"`
+
+	// AnalysisID set once the task succeeded
+	AnalysisID string `json:"analysisId,omitempty"`
+
+	// Branch analyzed
+	Branch string `json:"branch,omitempty"`
+
+	// BranchType BRANCH or SHORT
+	BranchType string `json:"branchType,omitempty"`
+
+	// PullRequest analyzed
+	PullRequest string `json:"pullRequest,omitempty"`
+
+	// SubmittedAt date and time
+	SubmittedAt string `json:"submittedAt"`
+
+	// StartedAt date and time
+	StartedAt string `json:"startedAt,omitempty"`
+
+	// ExecutedAt date and time
+	ExecutedAt string `json:"executedAt,omitempty"`
+
+	// ExecutionTimeMs time taken by the task
+	ExecutionTimeMs int `json:"executionTimeMs,omitempty"`
+
+	// ErrorMessage set when the task failed
+	ErrorMessage string `json:"errorMessage,omitempty"`
+
+	// WarningCount number of analysis warnings
+	WarningCount int `json:"warningCount"`
+
+	// Warnings list
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// Done return true once the task will not change anymore
+func (t CETaskObject) Done() bool {
+	return t.Status == TaskSuccess || t.Status == TaskFailed ||
+		t.Status == TaskCanceled
+}
+
+// CEActivityOptions used to filter Compute Engine activity
+type CEActivityOptions struct {
+	// Component (optional) is the SonarCloud project Key
+	Component string
+
+	// Statuses (optional) e.g. TaskFailed
+	Statuses []string
+
+	// Type (optional) e.g. REPORT
+	Type string
+
+	// OnlyCurrents (optional) only the most recent task per project
+	OnlyCurrents bool
+
+	// Page (optional) 1-based page number
+	Page int
+
+	// PageSize (optional) number of tasks per page
+	PageSize int
+}
+
+// ReportTask content of report-task.txt written by the scanner
+type ReportTask struct {
+	Organization  string
+	ProjectKey    string
+	ServerURL     string
+	ServerVersion string
+	Branch        string
+	DashboardURL  string
+	CETaskID      string
+	CETaskURL     string
+}
+
+// GetTask read a Compute Engine task
+//   example: GetTask(taskID)
+//   Unmarshal the response into a CETaskResponse
+func (c *SonarCloudClient) GetTask(id string) (*http.Response, error) {
+	return c.getTask(context.Background(), id)
+}
+
+// getTask GetTask using ctx
+func (c *SonarCloudClient) getTask(ctx context.Context, id string) (*http.Response, error) {
+	params := url.Values{}
+	params.Set("id", id)
+
+	return c.do(ctx, http.MethodGet, CETask, params)
+}
+
+// GetActivity search Compute Engine tasks
+//   example: GetActivity(CEActivityOptions{Component: key, OnlyCurrents: true})
+//   Unmarshal the response into a CEActivityResponse
+func (c *SonarCloudClient) GetActivity(o CEActivityOptions) (*http.Response, error) {
+	params := url.Values{}
+	setIfNotEmpty(params, "component", o.Component)
+	setIfNotEmpty(params, "status", strings.Join(o.Statuses, ","))
+	setIfNotEmpty(params, "type", o.Type)
+	if o.OnlyCurrents {
+		params.Set("onlyCurrents", "true")
+	}
+	setIfNotZero(params, "p", o.Page)
+	setIfNotZero(params, "ps", o.PageSize)
+
+	return c.get(CEActivity, params)
+}
+
+// WaitForAnalysis poll task taskID until it is done
+//   example: WaitForAnalysis(ctx, report.CETaskID)
+//   Polling starts after one second and backs off to 30 seconds
+//   Use ctx to bound the total time spent waiting
+//
+//   The final task is returned, its AnalysisID can be used with
+//   GetProjectStatus. A failed or canceled task returns an error
+//   along with the task
+func (c *SonarCloudClient) WaitForAnalysis(ctx context.Context, taskID string) (*CETaskObject, error) {
+	interval := waitInitialInterval
+
+	for {
+		rsp, err := c.getTask(ctx, taskID)
+		if err != nil {
+			return nil, err
+		}
+
+		var tr CETaskResponse
+		if err = decodeResponse(rsp, &tr); err != nil {
+			return nil, err
+		}
+
+		task := tr.Task
+		if task.Done() {
+			if task.Status != TaskSuccess {
+				return &task, fmt.Errorf("task %s %s: %s", taskID, task.Status, task.ErrorMessage)
+			}
+			return &task, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return &task, ctx.Err()
+		case <-timer.C:
+		}
+
+		interval *= 2
+		if interval > waitMaxInterval {
+			interval = waitMaxInterval
+		}
+	}
+}
+
+// ReadReportTask read report-task.txt, usually found in
+// .scannerwork/report-task.txt after running sonar-scanner
+func ReadReportTask(path string) (*ReportTask, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseReportTask(f)
+}
+
+// ParseReportTask parse the key=value lines of report-task.txt
+func ParseReportTask(r io.Reader) (*ReportTask, error) {
+	rt := &ReportTask{}
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		kv := strings.SplitN(line, "=", 2)
+		if len(kv) != 2 {
+			return nil, errors.New("invalid report task line: " + line)
+		}
+
+		v := strings.TrimSpace(kv[1])
+		switch strings.TrimSpace(kv[0]) {
+		case "organization":
+			rt.Organization = v
+		case "projectKey":
+			rt.ProjectKey = v
+		case "serverUrl":
+			rt.ServerURL = v
+		case "serverVersion":
+			rt.ServerVersion = v
+		case "branch":
+			rt.Branch = v
+		case "dashboardUrl":
+			rt.DashboardURL = v
+		case "ceTaskId":
+			rt.CETaskID = v
+		case "ceTaskUrl":
+			rt.CETaskURL = v
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if rt.CETaskID == "" {
+		return nil, errors.New("report task has no ceTaskId")
+	}
+
+	return rt, nil
+}
@@ -0,0 +1,101 @@
+package sonarcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+const reportTaskTxt = `organization=acme-demo
+projectKey=PavedRoad_test123
+serverUrl=https://sonarcloud.io
+serverVersion=8.0.0.16986
+dashboardUrl=https://sonarcloud.io/dashboard?id=PavedRoad_test123
+ceTaskId=AXT1
+ceTaskUrl=https://sonarcloud.io/api/ce/task?id=AXT1
+`
+
+// TestParseReportTask
+func TestParseReportTask(t *testing.T) {
+	rt, err := ParseReportTask(strings.NewReader(reportTaskTxt))
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	if rt.CETaskID != "AXT1" || rt.Organization != orgname ||
+		rt.DashboardURL != "https://sonarcloud.io/dashboard?id=PavedRoad_test123" {
+		t.Errorf("Unexpected report task %v\n", rt)
+	}
+
+	if _, err = ParseReportTask(strings.NewReader("projectKey=x\n")); err == nil {
+		t.Errorf("Expected error for missing ceTaskId\n")
+	}
+}
+
+// useFastPolling shorten WaitForAnalysis intervals for a test
+func useFastPolling(t *testing.T) {
+	initial, max := waitInitialInterval, waitMaxInterval
+	waitInitialInterval, waitMaxInterval = time.Millisecond, 4*time.Millisecond
+	t.Cleanup(func() {
+		waitInitialInterval, waitMaxInterval = initial, max
+	})
+}
+
+// TestWaitForAnalysis poll until the task succeeds
+func TestWaitForAnalysis(t *testing.T) {
+	useFastPolling(t)
+
+	statuses := []string{TaskPending, TaskInProgress, TaskInProgress, TaskSuccess}
+	calls := 0
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != CETask || r.URL.Query().Get("id") != "AXT1" {
+			t.Errorf("Unexpected request %v\n", r.URL)
+		}
+		status := statuses[calls]
+		calls++
+		analysis := ""
+		if status == TaskSuccess {
+			analysis = "AXA1"
+		}
+		fmt.Fprintf(w, `{"task": {"id": "AXT1", "type": "REPORT", "status": %q, "analysisId": %q}}`,
+			status, analysis)
+	})
+	defer srv.Close()
+
+	task, err := c.WaitForAnalysis(context.Background(), "AXT1")
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	if task.Status != TaskSuccess || task.AnalysisID != "AXA1" || calls != len(statuses) {
+		t.Errorf("Unexpected task %v after %d calls\n", task, calls)
+	}
+}
+
+// TestWaitForAnalysisFailed and context cancellation
+func TestWaitForAnalysisFailed(t *testing.T) {
+	useFastPolling(t)
+
+	status := TaskFailed
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"task": {"id": "AXT1", "status": %q, "errorMessage": "boom"}}`, status)
+	})
+	defer srv.Close()
+
+	task, err := c.WaitForAnalysis(context.Background(), "AXT1")
+	if err == nil || task == nil || task.Status != TaskFailed {
+		t.Errorf("Expected failed task Got %v %v\n", task, err)
+	}
+
+	status = TaskPending
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err = c.WaitForAnalysis(ctx, "AXT1")
+	if err == nil {
+		t.Errorf("Expected context error\n")
+	}
+}
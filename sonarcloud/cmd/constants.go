@@ -28,20 +28,272 @@ const (
 	// TokenRevoke URI
 	TokenRevoke = DefaultAPI + "/user_tokens/revoke"
 
+	// AuthValidate URI
+	AuthValidate = DefaultAPI + "/authentication/validate"
+
 	// BadgeMetric URI
 	BadgeMetric = DefaultAPI + "/project_badges/measure"
 
 	// QualityGate URI
 	QualityGate = DefaultAPI + "/project_badges/quality_gate"
 
+	// Dashboard URI of a project, not part of the API
+	Dashboard = "/dashboard"
+
+	// BadgeToken URI
+	BadgeToken = DefaultAPI + "/project_badges/token"
+
+	// BadgeRenewToken URI
+	BadgeRenewToken = DefaultAPI + "/project_badges/renew_token"
+
+	// ComponentShow URI
+	ComponentShow = DefaultAPI + "/components/show"
+
+	// HotspotSearch URI
+	HotspotSearch = DefaultAPI + "/hotspots/search"
+
+	// HotspotShow URI
+	HotspotShow = DefaultAPI + "/hotspots/show"
+
+	// HotspotChangeStatus URI
+	HotspotChangeStatus = DefaultAPI + "/hotspots/change_status"
+
+	// RuleSearch URI
+	RuleSearch = DefaultAPI + "/rules/search"
+
+	// RuleShow URI
+	RuleShow = DefaultAPI + "/rules/show"
+
+	// QualityGateList URI
+	QualityGateList = DefaultAPI + "/qualitygates/list"
+
+	// QualityGateCreate URI
+	QualityGateCreate = DefaultAPI + "/qualitygates/create"
+
+	// QualityGateCopy URI
+	QualityGateCopy = DefaultAPI + "/qualitygates/copy"
+
+	// QualityGateRename URI
+	QualityGateRename = DefaultAPI + "/qualitygates/rename"
+
+	// QualityGateCreateCondition URI
+	QualityGateCreateCondition = DefaultAPI + "/qualitygates/create_condition"
+
+	// QualityGateUpdateCondition URI
+	QualityGateUpdateCondition = DefaultAPI + "/qualitygates/update_condition"
+
+	// QualityGateDeleteCondition URI
+	QualityGateDeleteCondition = DefaultAPI + "/qualitygates/delete_condition"
+
+	// QualityGateSelect URI
+	QualityGateSelect = DefaultAPI + "/qualitygates/select"
+
+	// QualityGateDeselect URI
+	QualityGateDeselect = DefaultAPI + "/qualitygates/deselect"
+
+	// QualityGateProjectStatus URI
+	QualityGateProjectStatus = DefaultAPI + "/qualitygates/project_status"
+
+	// QualityProfileSearch URI
+	QualityProfileSearch = DefaultAPI + "/qualityprofiles/search"
+
+	// QualityProfileAddProject URI
+	QualityProfileAddProject = DefaultAPI + "/qualityprofiles/add_project"
+
+	// QualityProfileRemoveProject URI
+	QualityProfileRemoveProject = DefaultAPI + "/qualityprofiles/remove_project"
+
+	// QualityProfileBackup URI
+	QualityProfileBackup = DefaultAPI + "/qualityprofiles/backup"
+
+	// QualityProfileRestore URI
+	QualityProfileRestore = DefaultAPI + "/qualityprofiles/restore"
+
+	// WebhookCreate URI
+	WebhookCreate = DefaultAPI + "/webhooks/create"
+
+	// WebhookList URI
+	WebhookList = DefaultAPI + "/webhooks/list"
+
+	// WebhookUpdate URI
+	WebhookUpdate = DefaultAPI + "/webhooks/update"
+
+	// WebhookDelete URI
+	WebhookDelete = DefaultAPI + "/webhooks/delete"
+
+	// WebhookDeliveries URI
+	WebhookDeliveries = DefaultAPI + "/webhooks/deliveries"
+
+	// WebhookDelivery URI
+	WebhookDelivery = DefaultAPI + "/webhooks/delivery"
+
+	// CETask URI
+	CETask = DefaultAPI + "/ce/task"
+
+	// CEActivity URI
+	CEActivity = DefaultAPI + "/ce/activity"
+
+	// AnalysisSearch URI
+	AnalysisSearch = DefaultAPI + "/project_analyses/search"
+
+	// AnalysisCreateEvent URI
+	AnalysisCreateEvent = DefaultAPI + "/project_analyses/create_event"
+
+	// AnalysisUpdateEvent URI
+	AnalysisUpdateEvent = DefaultAPI + "/project_analyses/update_event"
+
+	// AnalysisDeleteEvent URI
+	AnalysisDeleteEvent = DefaultAPI + "/project_analyses/delete_event"
+
+	// OrganizationSearch URI
+	OrganizationSearch = DefaultAPI + "/organizations/search"
+
+	// OrganizationUpdate URI
+	OrganizationUpdate = DefaultAPI + "/organizations/update"
+
+	// OrganizationSearchMembers URI
+	OrganizationSearchMembers = DefaultAPI + "/organizations/search_members"
+
+	// OrganizationAddMember URI
+	OrganizationAddMember = DefaultAPI + "/organizations/add_member"
+
+	// OrganizationRemoveMember URI
+	OrganizationRemoveMember = DefaultAPI + "/organizations/remove_member"
+
+	// PermissionAddUser URI
+	PermissionAddUser = DefaultAPI + "/permissions/add_user"
+
+	// PermissionRemoveUser URI
+	PermissionRemoveUser = DefaultAPI + "/permissions/remove_user"
+
+	// PermissionAddGroup URI
+	PermissionAddGroup = DefaultAPI + "/permissions/add_group"
+
+	// PermissionRemoveGroup URI
+	PermissionRemoveGroup = DefaultAPI + "/permissions/remove_group"
+
+	// PermissionCreateTemplate URI
+	PermissionCreateTemplate = DefaultAPI + "/permissions/create_template"
+
+	// PermissionAddGroupToTemplate URI
+	PermissionAddGroupToTemplate = DefaultAPI + "/permissions/add_group_to_template"
+
+	// PermissionApplyTemplate URI
+	PermissionApplyTemplate = DefaultAPI + "/permissions/apply_template"
+
+	// UserGroupSearch URI
+	UserGroupSearch = DefaultAPI + "/user_groups/search"
+
+	// UserGroupCreate URI
+	UserGroupCreate = DefaultAPI + "/user_groups/create"
+
+	// UserGroupUpdate URI
+	UserGroupUpdate = DefaultAPI + "/user_groups/update"
+
+	// UserGroupDelete URI
+	UserGroupDelete = DefaultAPI + "/user_groups/delete"
+
+	// UserGroupAddUser URI
+	UserGroupAddUser = DefaultAPI + "/user_groups/add_user"
+
+	// UserGroupRemoveUser URI
+	UserGroupRemoveUser = DefaultAPI + "/user_groups/remove_user"
+
+	// UserGroupUsers URI
+	UserGroupUsers = DefaultAPI + "/user_groups/users"
+
+	// SettingValues URI
+	SettingValues = DefaultAPI + "/settings/values"
+
+	// SettingSet URI
+	SettingSet = DefaultAPI + "/settings/set"
+
+	// SettingReset URI
+	SettingReset = DefaultAPI + "/settings/reset"
+
+	// NewCodePeriodShow URI
+	NewCodePeriodShow = DefaultAPI + "/new_code_periods/show"
+
+	// NewCodePeriodSet URI
+	NewCodePeriodSet = DefaultAPI + "/new_code_periods/set"
+
+	// NewCodePeriodUnset URI
+	NewCodePeriodUnset = DefaultAPI + "/new_code_periods/unset"
+
+	// NewCodePeriodList URI
+	NewCodePeriodList = DefaultAPI + "/new_code_periods/list"
+
+	// ProjectTagSet URI
+	ProjectTagSet = DefaultAPI + "/project_tags/set"
+
+	// ProjectTagSearch URI
+	ProjectTagSearch = DefaultAPI + "/project_tags/search"
+
+	// ProjectLinkCreate URI
+	ProjectLinkCreate = DefaultAPI + "/project_links/create"
+
+	// ProjectLinkSearch URI
+	ProjectLinkSearch = DefaultAPI + "/project_links/search"
+
+	// ProjectLinkDelete URI
+	ProjectLinkDelete = DefaultAPI + "/project_links/delete"
+
+	// SourceLines URI
+	SourceLines = DefaultAPI + "/sources/lines"
+
+	// SourceRaw URI
+	SourceRaw = DefaultAPI + "/sources/raw"
+
+	// DuplicationShow URI
+	DuplicationShow = DefaultAPI + "/duplications/show"
+
+	// ALMSettingList URI
+	ALMSettingList = DefaultAPI + "/alm_settings/list"
+
+	// ALMSetGitHubBinding URI
+	ALMSetGitHubBinding = DefaultAPI + "/alm_settings/set_github_binding"
+
+	// ALMGetBinding URI
+	ALMGetBinding = DefaultAPI + "/alm_settings/get_binding"
+
+	// ALMDeleteBinding URI
+	ALMDeleteBinding = DefaultAPI + "/alm_settings/delete_binding"
+
+	// ALMValidateBinding URI
+	ALMValidateBinding = DefaultAPI + "/alm_settings/validate_binding"
+
+	// NotificationAdd URI
+	NotificationAdd = DefaultAPI + "/notifications/add"
+
+	// NotificationRemove URI
+	NotificationRemove = DefaultAPI + "/notifications/remove"
+
+	// NotificationList URI
+	NotificationList = DefaultAPI + "/notifications/list"
+
+	// FavoriteAdd URI
+	FavoriteAdd = DefaultAPI + "/favorites/add"
+
+	// FavoriteRemove URI
+	FavoriteRemove = DefaultAPI + "/favorites/remove"
+
+	// FavoriteSearch URI
+	FavoriteSearch = DefaultAPI + "/favorites/search"
+
 	// Query parameter strings
 	Branch       = "branch=%s"
+	ID           = "id=%s"
 	Login        = "login=%s"
-	Metric       = "metric=%s"
+	MetricParam  = "metric=%s"
 	Name         = "name=%s"
 	Organization = "organization=%s"
 	Project      = "project=%s"
 	Projects     = "projects=%s"
+	PullRequest  = "pullRequest=%s"
+	Token        = "token=%s"
+
+	// WebhookSignatureHeader holds the HMAC-SHA256 of a webhook payload
+	WebhookSignatureHeader = "X-Sonar-Webhook-HMAC-SHA256"
 
 	// KeyPrefix to append to SonarCloud Key to ensure uniqueness
 	KeyPrefix = "PavedRoad_"
@@ -55,9 +307,25 @@ const (
 	badServerAddress = "localhost:3000"
 )
 
+// Date formats used by SonarCloud
+const (
+	// DateTimeLayout used by createdAt, analysedAt and similar fields
+	DateTimeLayout = "2006-01-02T15:04:05-0700"
+
+	// DateLayout used by date only parameters
+	DateLayout = "2006-01-02"
+)
+
+// Token types
+const (
+	UserToken            = "USER_TOKEN"
+	ProjectAnalysisToken = "PROJECT_ANALYSIS_TOKEN"
+	GlobalAnalysisToken  = "GLOBAL_ANALYSIS_TOKEN"
+)
+
 // Valid metric types
 const (
-	Bugs = iota
+	Bugs Metric = iota
 	CodeSmells
 	Coverage
 	DuplicatedLinesDensity
@@ -70,8 +338,8 @@ const (
 	Vulnerabilities
 )
 
-// MetricName maps integer constants to expected Sonar string name
-var MetricName = map[int]string{
+// MetricName maps Metric constants to expected Sonar string name
+var MetricName = map[Metric]string{
 	0:  "bugs",
 	1:  "code_smells",
 	2:  "coverage",
@@ -85,6 +353,78 @@ var MetricName = map[int]string{
 	10: "vulnerabilities",
 }
 
+// Valid badge output types
+const (
+	MarkDown = iota
+	HTML
+	Link
+)
+
+// Valid hotspot statuses and resolutions
+const (
+	HotspotToReview = "TO_REVIEW"
+	HotspotReviewed = "REVIEWED"
+
+	HotspotFixed        = "FIXED"
+	HotspotSafe         = "SAFE"
+	HotspotAcknowledged = "ACKNOWLEDGED"
+)
+
+// Quality gate condition operators and statuses
+const (
+	OperatorLessThan    = "LT"
+	OperatorGreaterThan = "GT"
+
+	GateOK    = "OK"
+	GateWarn  = "WARN"
+	GateError = "ERROR"
+	GateNone  = "NONE"
+)
+
+// Compute Engine task statuses
+const (
+	TaskPending    = "PENDING"
+	TaskInProgress = "IN_PROGRESS"
+	TaskSuccess    = "SUCCESS"
+	TaskFailed     = "FAILED"
+	TaskCanceled   = "CANCELED"
+)
+
+// Analysis event categories
+const (
+	EventVersion     = "VERSION"
+	EventOther       = "OTHER"
+	EventQualityGate = "QUALITY_GATE"
+)
+
+// Project permissions
+const (
+	PermissionAdmin           = "admin"
+	PermissionBrowse          = "user"
+	PermissionCodeViewer      = "codeviewer"
+	PermissionIssueAdmin      = "issueadmin"
+	PermissionHotspotAdmin    = "securityhotspotadmin"
+	PermissionExecuteAnalysis = "scan"
+)
+
+// New code period types
+const (
+	PreviousVersion = "PREVIOUS_VERSION"
+	NumberOfDays    = "NUMBER_OF_DAYS"
+	ReferenceBranch = "REFERENCE_BRANCH"
+)
+
+// Notification types and channels
+const (
+	NotificationNewIssues        = "NewIssues"
+	NotificationMyNewIssues      = "SQ-MyNewIssues"
+	NotificationChangesOnMyIssue = "ChangesOnMyIssue"
+	NotificationQualityGate      = "NewAlerts"
+	NotificationTaskFailure      = "CeReportTaskFailure"
+
+	EmailChannel = "EmailNotificationChannel"
+)
+
 const (
 	testErrorMsg      = "Expected err to be nil Got %v\n"
 	testErrorMsgValue = "Expected err to be '%v' Got %v\n"
@@ -0,0 +1,57 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// FavoriteSearchResponse for a GET / favorites/search
+type FavoriteSearchResponse struct {
+	Paging    PagingObject     `json:"paging"`
+	Favorites []FavoriteObject `json:"favorites"`
+}
+
+// FavoriteObject a component marked as favorite
+type FavoriteObject struct {
+	// Key of the component
+	Key string `json:"key"`
+
+	// Name for display
+	Name string `json:"name"`
+
+	// Qualifier TRK for projects
+	Qualifier string `json:"qualifier"`
+
+	// Organization of the component
+	Organization string `json:"organization,omitempty"`
+}
+
+// AddFavorite mark a component as favorite for the token owner
+//   example: AddFavorite(projectKey)
+func (c *SonarCloudClient) AddFavorite(component string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("component", component)
+
+	return c.post(FavoriteAdd, data)
+}
+
+// RemoveFavorite unmark a favorite component
+//   example: RemoveFavorite(projectKey)
+func (c *SonarCloudClient) RemoveFavorite(component string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("component", component)
+
+	return c.post(FavoriteRemove, data)
+}
+
+// SearchFavorites list the favorites of the token owner
+//   example: SearchFavorites(page, pageSize)
+//   page and pageSize are optional, 0 uses the server default
+//   Unmarshal the response into a FavoriteSearchResponse
+func (c *SonarCloudClient) SearchFavorites(page, pageSize int) (*http.Response, error) {
+	params := url.Values{}
+	setIfNotZero(params, "p", page)
+	setIfNotZero(params, "ps", pageSize)
+
+	return c.get(FavoriteSearch, params)
+}
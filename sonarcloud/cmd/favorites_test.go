@@ -0,0 +1,50 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+)
+
+// TestSearchFavorites
+func TestSearchFavorites(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("ps") != "50" {
+			t.Errorf("Unexpected query %v\n", r.URL.Query())
+		}
+		w.Write([]byte(`{"paging": {"pageIndex": 1, "pageSize": 50, "total": 1},
+		  "favorites": [{"key": "PavedRoad_test123", "name": "Test project 123",
+		  "qualifier": "TRK", "organization": "acme-demo"}]}`))
+	})
+	defer srv.Close()
+
+	rsp, err := c.SearchFavorites(0, 50)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var fs FavoriteSearchResponse
+	if err = decodeResponse(rsp, &fs); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	if len(fs.Favorites) != 1 || fs.Favorites[0].Qualifier != "TRK" {
+		t.Errorf("Unexpected favorites %v\n", fs.Favorites)
+	}
+}
+
+// TestAddFavorite
+func TestAddFavorite(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.FormValue("component") != projectKey {
+			t.Errorf("Unexpected request %v %v\n", r.Method, r.Form)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer srv.Close()
+
+	rsp, err := c.AddFavorite(projectKey)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	checkResponseCode(t, http.StatusNoContent, rsp.StatusCode)
+}
@@ -0,0 +1,280 @@
+package sonarcloud
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// HotspotSearchOptions used to filter a hotspot search
+//   Project or Hotspots is required
+type HotspotSearchOptions struct {
+	// Project is the SonarCloud Key
+	Project string
+
+	// Branch (optional) a long living branch
+	Branch string
+
+	// PullRequest (optional) pull request id
+	PullRequest string
+
+	// Hotspots (optional) list of hotspot keys
+	Hotspots []string
+
+	// Status (optional) HotspotToReview or HotspotReviewed
+	Status string
+
+	// Resolution (optional) only valid with HotspotReviewed
+	Resolution string
+
+	// OnlyMine (optional) hotspots assigned to the current user
+	OnlyMine bool
+
+	// Page (optional) 1-based page number
+	Page int
+
+	// PageSize (optional) number of hotspots per page
+	PageSize int
+}
+
+// HotspotSearchResponse for a GET / search on hotspots
+type HotspotSearchResponse struct {
+	// Paging object
+	Paging PagingObject `json:"paging"`
+
+	// Hotspots list
+	Hotspots []HotspotObject `json:"hotspots"`
+
+	// Components referenced by the hotspots
+	Components []HotspotComponent `json:"components"`
+}
+
+// HotspotObject a single security hotspot
+type HotspotObject struct {
+	// Key for access this object
+	Key string `json:"key"`
+
+	// Component the hotspot was found in
+	Component string `json:"component"`
+
+	// Project the hotspot belongs to
+	Project string `json:"project"`
+
+	// SecurityCategory e.g. sql-injection
+	SecurityCategory string `json:"securityCategory"`
+
+	// VulnerabilityProbability HIGH, MEDIUM or LOW
+	VulnerabilityProbability string `json:"vulnerabilityProbability"`
+
+	// Status HotspotToReview or HotspotReviewed
+	Status string `json:"status"`
+
+	// Resolution set once the hotspot is reviewed
+	Resolution string `json:"resolution,omitempty"`
+
+	// Line number in the component
+	Line int `json:"line"`
+
+	// Message describing the hotspot
+	Message string `json:"message"`
+
+	// Assignee login
+	Assignee string `json:"assignee,omitempty"`
+
+	// Author from SCM
+	Author string `json:"author"`
+
+	// CreationDate date and time
+	CreationDate string `json:"creationDate"`
+
+	// UpdateDate date and time
+	UpdateDate string `json:"updateDate"`
+
+	// RuleKey that raised the hotspot
+	RuleKey string `json:"ruleKey"`
+}
+
+// HotspotComponent file or project a hotspot refers to
+type HotspotComponent struct {
+	// Organization name
+	Organization string `json:"organization,omitempty"`
+
+	// Key for access this object
+	Key string `json:"key"`
+
+	// Qualifier is type of component
+	Qualifier string `json:"qualifier"`
+
+	// Name for display
+	Name string `json:"name"`
+
+	// LongName for display
+	LongName string `json:"longName"`
+
+	// Path relative to the project root
+	Path string `json:"path,omitempty"`
+}
+
+// HotspotRule rule that raised a hotspot
+type HotspotRule struct {
+	// Key of the rule
+	Key string `json:"key"`
+
+	// Name for display
+	Name string `json:"name"`
+
+	// SecurityCategory e.g. sql-injection
+	SecurityCategory string `json:"securityCategory"`
+
+	// VulnerabilityProbability HIGH, MEDIUM or LOW
+	VulnerabilityProbability string `json:"vulnerabilityProbability"`
+
+	// RiskDescription HTML
+	RiskDescription string `json:"riskDescription"`
+
+	// VulnerabilityDescription HTML
+	VulnerabilityDescription string `json:"vulnerabilityDescription"`
+
+	// FixRecommendations HTML
+	FixRecommendations string `json:"fixRecommendations"`
+}
+
+// HotspotChangelog a change made to a hotspot
+type HotspotChangelog struct {
+	// User login that made the change
+	User string `json:"user"`
+
+	// CreationDate date and time
+	CreationDate string `json:"creationDate"`
+
+	// Diffs list of changed fields
+	Diffs []HotspotDiff `json:"diffs"`
+}
+
+// HotspotDiff a single changed field
+type HotspotDiff struct {
+	Key      string `json:"key"`
+	NewValue string `json:"newValue"`
+	OldValue string `json:"oldValue"`
+}
+
+// HotspotComment a review comment
+type HotspotComment struct {
+	Key       string `json:"key"`
+	Login     string `json:"login"`
+	HTMLText  string `json:"htmlText"`
+	Markdown  string `json:"markdown"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// HotspotShowResponse for a GET / show on a hotspot
+type HotspotShowResponse struct {
+	// Key for access this object
+	Key string `json:"key"`
+
+	// Component the hotspot was found in
+	Component HotspotComponent `json:"component"`
+
+	// Project the hotspot belongs to
+	Project HotspotComponent `json:"project"`
+
+	// Rule that raised the hotspot
+	Rule HotspotRule `json:"rule"`
+
+	// Status HotspotToReview or HotspotReviewed
+	Status string `json:"status"`
+
+	// Resolution set once the hotspot is reviewed
+	Resolution string `json:"resolution,omitempty"`
+
+	// Line number in the component
+	Line int `json:"line"`
+
+	// Message describing the hotspot
+	Message string `json:"message"`
+
+	// Assignee login
+	Assignee string `json:"assignee,omitempty"`
+
+	// Author from SCM
+	Author string `json:"author"`
+
+	// CreationDate date and time
+	CreationDate string `json:"creationDate"`
+
+	// UpdateDate date and time
+	UpdateDate string `json:"updateDate"`
+
+	// Changelog history of the hotspot
+	Changelog []HotspotChangelog `json:"changelog"`
+
+	// Comment list
+	Comment []HotspotComment `json:"comment"`
+
+	// CanChangeStatus true if the current user can review it
+	CanChangeStatus bool `json:"canChangeStatus"`
+}
+
+// SearchHotspots search security hotspots
+//   example: SearchHotspots(HotspotSearchOptions{Project: key})
+//   Unmarshal the response into a HotspotSearchResponse
+func (c *SonarCloudClient) SearchHotspots(o HotspotSearchOptions) (*http.Response, error) {
+	if o.Project == "" && len(o.Hotspots) == 0 {
+		return nil, errors.New("project or hotspots is required")
+	}
+
+	params := url.Values{}
+	setIfNotEmpty(params, "projectKey", o.Project)
+	setIfNotEmpty(params, "branch", o.Branch)
+	setIfNotEmpty(params, "pullRequest", o.PullRequest)
+	setIfNotEmpty(params, "hotspots", strings.Join(o.Hotspots, ","))
+	setIfNotEmpty(params, "status", o.Status)
+	setIfNotEmpty(params, "resolution", o.Resolution)
+	if o.OnlyMine {
+		params.Set("onlyMine", "true")
+	}
+	setIfNotZero(params, "p", o.Page)
+	setIfNotZero(params, "ps", o.PageSize)
+
+	return c.get(HotspotSearch, params)
+}
+
+// GetHotspot read a single hotspot with its rule and changelog
+//   example: GetHotspot(hotspotKey)
+//   Unmarshal the response into a HotspotShowResponse
+func (c *SonarCloudClient) GetHotspot(key string) (*http.Response, error) {
+	params := url.Values{}
+	params.Set("hotspot", key)
+
+	return c.get(HotspotShow, params)
+}
+
+// ChangeHotspotStatus review a hotspot
+//   example: ChangeHotspotStatus(key, HotspotReviewed, HotspotSafe, comment)
+//   resolution is required when status is HotspotReviewed
+//   and must be empty when status is HotspotToReview
+//   comment (optional) is added to the hotspot
+func (c *SonarCloudClient) ChangeHotspotStatus(key, status, resolution, comment string) (*http.Response, error) {
+	switch status {
+	case HotspotToReview:
+		if resolution != "" {
+			return nil, errors.New("resolution must be empty when status is " + HotspotToReview)
+		}
+	case HotspotReviewed:
+		if resolution != HotspotFixed && resolution != HotspotSafe &&
+			resolution != HotspotAcknowledged {
+			return nil, errors.New("invalid resolution: " + resolution)
+		}
+	default:
+		return nil, errors.New("invalid status: " + status)
+	}
+
+	data := url.Values{}
+	data.Set("hotspot", key)
+	data.Set("status", status)
+	setIfNotEmpty(data, "resolution", resolution)
+	setIfNotEmpty(data, "comment", comment)
+
+	return c.post(HotspotChangeStatus, data)
+}
@@ -0,0 +1,96 @@
+package sonarcloud
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+const hotspotSearchJSON = `{
+  "paging": {"pageIndex": 1, "pageSize": 100, "total": 1},
+  "hotspots": [{
+    "key": "AXJ1",
+    "component": "PavedRoad_test123:main.go",
+    "project": "PavedRoad_test123",
+    "securityCategory": "weak-cryptography",
+    "vulnerabilityProbability": "MEDIUM",
+    "status": "TO_REVIEW",
+    "line": 42,
+    "message": "Make sure this weak hash algorithm is not used in a sensitive context here.",
+    "author": "dev@acme.io",
+    "creationDate": "2020-08-01T10:00:00+0000",
+    "updateDate": "2020-08-01T10:00:00+0000",
+    "ruleKey": "go:S4790"
+  }],
+  "components": [{
+    "key": "PavedRoad_test123:main.go",
+    "qualifier": "FIL",
+    "name": "main.go",
+    "longName": "main.go",
+    "path": "main.go"
+  }]
+}`
+
+// TestSearchHotspots
+func TestSearchHotspots(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("projectKey") != projectKey || q.Get("status") != HotspotToReview {
+			t.Errorf("Unexpected query %v\n", q)
+		}
+		w.Write([]byte(hotspotSearchJSON))
+	})
+	defer srv.Close()
+
+	rsp, err := c.SearchHotspots(HotspotSearchOptions{
+		Project: projectKey,
+		Status:  HotspotToReview,
+	})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	body, err := ioutil.ReadAll(rsp.Body)
+	if err != nil {
+		t.Errorf(testErrorMsg, err)
+	}
+
+	var hs HotspotSearchResponse
+	if err = json.Unmarshal(body, &hs); err != nil {
+		t.Errorf(testMarshalFail, err)
+	}
+
+	if len(hs.Hotspots) != 1 || hs.Hotspots[0].VulnerabilityProbability != "MEDIUM" {
+		t.Errorf("Unexpected hotspots %v\n", hs.Hotspots)
+	}
+
+	if _, err = c.SearchHotspots(HotspotSearchOptions{}); err == nil {
+		t.Errorf("Expected error for missing project\n")
+	}
+}
+
+// TestChangeHotspotStatus
+func TestChangeHotspotStatus(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("status") != HotspotReviewed || r.FormValue("resolution") != HotspotSafe {
+			t.Errorf("Unexpected form %v\n", r.Form)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer srv.Close()
+
+	rsp, err := c.ChangeHotspotStatus("AXJ1", HotspotReviewed, HotspotSafe, "not sensitive")
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	checkResponseCode(t, http.StatusNoContent, rsp.StatusCode)
+
+	if _, err = c.ChangeHotspotStatus("AXJ1", HotspotReviewed, "", ""); err == nil {
+		t.Errorf("Expected error for missing resolution\n")
+	}
+
+	if _, err = c.ChangeHotspotStatus("AXJ1", HotspotToReview, HotspotSafe, ""); err == nil {
+		t.Errorf("Expected error for unexpected resolution\n")
+	}
+}
@@ -0,0 +1,110 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Metric a measure SonarCloud can produce a badge for
+//   Metric implements encoding.TextMarshaler and encoding.TextUnmarshaler
+//   so it can be used in config files, and *Metric is a flag.Value
+type Metric int
+
+// metricInfo display information for a metric
+type metricInfo struct {
+	label string
+	unit  string
+}
+
+// Units of metric values
+const (
+	UnitCount   = ""
+	UnitPercent = "%"
+	UnitLines   = "lines"
+	UnitRating  = "rating"
+	UnitMinutes = "min"
+	UnitStatus  = "status"
+)
+
+var metricInfos = map[Metric]metricInfo{
+	Bugs:                   {"Bugs", UnitCount},
+	CodeSmells:             {"Code Smells", UnitCount},
+	Coverage:               {"Coverage", UnitPercent},
+	DuplicatedLinesDensity: {"Duplicated Lines (%)", UnitPercent},
+	Ncloc:                  {"Lines of Code", UnitLines},
+	SqaleRating:            {"Maintainability Rating", UnitRating},
+	AlertStatus:            {"Quality Gate Status", UnitStatus},
+	ReliabilityRating:      {"Reliability Rating", UnitRating},
+	SecurityRating:         {"Security Rating", UnitRating},
+	SqaleIndex:             {"Technical Debt", UnitMinutes},
+	Vulnerabilities:        {"Vulnerabilities", UnitCount},
+}
+
+// AllMetrics return every valid metric in declaration order
+func AllMetrics() []Metric {
+	m := make([]Metric, 0, len(MetricName))
+	for i := Bugs; i <= Vulnerabilities; i++ {
+		m = append(m, i)
+	}
+	return m
+}
+
+// ParseMetric return the Metric for a Sonar name such as "code_smells"
+//   example: ParseMetric("coverage")
+//   Case and surrounding spaces are ignored
+func ParseMetric(name string) (Metric, error) {
+	n := strings.ToLower(strings.TrimSpace(name))
+	for m, v := range MetricName {
+		if v == n {
+			return m, nil
+		}
+	}
+	return -1, fmt.Errorf("unknown metric %q", name)
+}
+
+// Valid true if m is a known metric
+func (m Metric) Valid() bool {
+	_, ok := MetricName[m]
+	return ok
+}
+
+// String return the Sonar name of m
+func (m Metric) String() string {
+	if n, ok := MetricName[m]; ok {
+		return n
+	}
+	return fmt.Sprintf("Metric(%d)", int(m))
+}
+
+// Label return a name for display e.g. "Code Smells"
+func (m Metric) Label() string {
+	return metricInfos[m].label
+}
+
+// Unit return the unit of the metric value, UnitCount for plain counts
+func (m Metric) Unit() string {
+	return metricInfos[m].unit
+}
+
+// MarshalText encode m as its Sonar name
+func (m Metric) MarshalText() ([]byte, error) {
+	if !m.Valid() {
+		return nil, fmt.Errorf("invalid metric %d", int(m))
+	}
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText decode a Sonar name into m
+func (m *Metric) UnmarshalText(text []byte) error {
+	v, err := ParseMetric(string(text))
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// Set parse value into m so *Metric can be used with flag.Var
+func (m *Metric) Set(value string) error {
+	return m.UnmarshalText([]byte(value))
+}
@@ -0,0 +1,83 @@
+package sonarcloud
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+)
+
+// TestParseMetric every metric round trips through its name
+func TestParseMetric(t *testing.T) {
+	for _, m := range AllMetrics() {
+		got, err := ParseMetric(m.String())
+		if err != nil {
+			t.Fatalf(testErrorMsg, err)
+		}
+		if got != m {
+			t.Errorf(testErrorMsgValue, m, got)
+		}
+		if m.Label() == "" {
+			t.Errorf("Expected a label for %v\n", m)
+		}
+	}
+
+	if m, err := ParseMetric(" Code_Smells "); err != nil || m != CodeSmells {
+		t.Errorf(testErrorMsgValue, CodeSmells, m)
+	}
+
+	if _, err := ParseMetric("lines_to_cover"); err == nil {
+		t.Errorf("Expected error for unknown metric\n")
+	}
+
+	if Metric(42).Valid() || Metric(42).String() != "Metric(42)" {
+		t.Errorf("Expected Metric(42) to be invalid\n")
+	}
+}
+
+// TestMetricText metrics in config files and flags
+func TestMetricText(t *testing.T) {
+	var cfg struct {
+		Metrics []Metric `json:"metrics"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"metrics": ["coverage", "sqale_index"]}`), &cfg); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+	if len(cfg.Metrics) != 2 || cfg.Metrics[1] != SqaleIndex || cfg.Metrics[1].Unit() != UnitMinutes {
+		t.Errorf("Unexpected metrics %v\n", cfg.Metrics)
+	}
+
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	if string(b) != `{"metrics":["coverage","sqale_index"]}` {
+		t.Errorf("Unexpected JSON %s\n", b)
+	}
+
+	if err = json.Unmarshal([]byte(`{"metrics": ["speed"]}`), &cfg); err == nil {
+		t.Errorf("Expected error for unknown metric\n")
+	}
+
+	if _, err = json.Marshal([]Metric{-1}); err == nil {
+		t.Errorf("Expected error for invalid metric\n")
+	}
+
+	m := Bugs
+	fs := flag.NewFlagSet("badge", flag.ContinueOnError)
+	fs.Var(&m, "metric", "badge metric")
+	if err = fs.Parse([]string{"-metric", "vulnerabilities"}); err != nil || m != Vulnerabilities {
+		t.Errorf(testErrorMsgValue, Vulnerabilities, m)
+	}
+}
+
+// TestGetMetricInvalid invalid metrics are rejected before any request
+func TestGetMetricInvalid(t *testing.T) {
+	c := &SonarCloudClient{}
+	if _, err := c.GetMetric(Metric(99), projectKey, ""); err == nil {
+		t.Errorf("Expected error for invalid metric\n")
+	}
+	if c.NameToEnum("bogus") != -1 || c.NameToEnum("NCLOC") != int(Ncloc) {
+		t.Errorf("Unexpected NameToEnum result\n")
+	}
+}
@@ -0,0 +1,103 @@
+package sonarcloud
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// NewCodePeriod defines what is "new code" for a project or branch
+type NewCodePeriod struct {
+	// Project is the SonarCloud Key
+	Project string `json:"projectKey"`
+
+	// Branch (optional) applies the period to this branch only
+	Branch string `json:"branchKey,omitempty"`
+
+	// Type PreviousVersion, NumberOfDays or ReferenceBranch
+	Type string `json:"type"`
+
+	// Value number of days or reference branch name,
+	// empty for PreviousVersion
+	Value string `json:"value,omitempty"`
+
+	// EffectiveValue resolved value, only set in responses
+	EffectiveValue string `json:"effectiveValue,omitempty"`
+
+	// Inherited true if the period comes from the project or
+	// organization, only set in responses
+	Inherited bool `json:"inherited,omitempty"`
+}
+
+// NewCodePeriodListResponse for a GET / new_code_periods/list
+type NewCodePeriodListResponse struct {
+	NewCodePeriods []NewCodePeriod `json:"newCodePeriods"`
+}
+
+// GetNewCodePeriod read the new code period of a project or branch
+//   example: GetNewCodePeriod(projectKey, branch)
+//   branch (optional) read the branch period
+//   Unmarshal the response into a NewCodePeriod
+func (c *SonarCloudClient) GetNewCodePeriod(project, branch string) (*http.Response, error) {
+	params := url.Values{}
+	params.Set("project", project)
+	setIfNotEmpty(params, "branch", branch)
+
+	return c.get(NewCodePeriodShow, params)
+}
+
+// SetNewCodePeriod set the new code period of p.Project or p.Branch
+//   example: SetNewCodePeriod(NewCodePeriod{Project: key,
+//		Type: NumberOfDays, Value: "30"})
+func (c *SonarCloudClient) SetNewCodePeriod(p NewCodePeriod) (*http.Response, error) {
+	if p.Project == "" {
+		return nil, errors.New("project is required")
+	}
+
+	switch p.Type {
+	case PreviousVersion:
+		if p.Value != "" {
+			return nil, errors.New("value must be empty for " + PreviousVersion)
+		}
+	case NumberOfDays:
+		if days, err := strconv.Atoi(p.Value); err != nil || days < 1 {
+			return nil, errors.New("value must be a positive number of days")
+		}
+	case ReferenceBranch:
+		if p.Value == "" {
+			return nil, errors.New("value must be the reference branch")
+		}
+	default:
+		return nil, errors.New("invalid new code period type: " + p.Type)
+	}
+
+	data := url.Values{}
+	data.Set("project", p.Project)
+	data.Set("type", p.Type)
+	setIfNotEmpty(data, "branch", p.Branch)
+	setIfNotEmpty(data, "value", p.Value)
+
+	return c.post(NewCodePeriodSet, data)
+}
+
+// UnsetNewCodePeriod remove the period so the inherited one applies
+//   example: UnsetNewCodePeriod(projectKey, branch)
+//   branch (optional) unset the branch period only
+func (c *SonarCloudClient) UnsetNewCodePeriod(project, branch string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("project", project)
+	setIfNotEmpty(data, "branch", branch)
+
+	return c.post(NewCodePeriodUnset, data)
+}
+
+// ListNewCodePeriods list the new code period of every branch
+//   example: ListNewCodePeriods(projectKey)
+//   Unmarshal the response into a NewCodePeriodListResponse
+func (c *SonarCloudClient) ListNewCodePeriods(project string) (*http.Response, error) {
+	params := url.Values{}
+	params.Set("project", project)
+
+	return c.get(NewCodePeriodList, params)
+}
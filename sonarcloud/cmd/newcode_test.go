@@ -0,0 +1,69 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+)
+
+// TestSetNewCodePeriod
+func TestSetNewCodePeriod(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("type") != ReferenceBranch || r.FormValue("value") != "main" ||
+			r.FormValue("branch") != "release-1.0" {
+			t.Errorf("Unexpected form %v\n", r.Form)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer srv.Close()
+
+	rsp, err := c.SetNewCodePeriod(NewCodePeriod{
+		Project: projectKey,
+		Branch:  "release-1.0",
+		Type:    ReferenceBranch,
+		Value:   "main",
+	})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	checkResponseCode(t, http.StatusNoContent, rsp.StatusCode)
+
+	invalid := []NewCodePeriod{
+		{Project: projectKey, Type: NumberOfDays, Value: "a month"},
+		{Project: projectKey, Type: PreviousVersion, Value: "1.0"},
+		{Project: projectKey, Type: ReferenceBranch},
+		{Project: projectKey, Type: "SPECIFIC_DATE"},
+		{Type: PreviousVersion},
+	}
+
+	for _, p := range invalid {
+		if _, err = c.SetNewCodePeriod(p); err == nil {
+			t.Errorf("Expected error for %v\n", p)
+		}
+	}
+}
+
+// TestListNewCodePeriods
+func TestListNewCodePeriods(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"newCodePeriods": [
+		  {"projectKey": "PavedRoad_test123", "branchKey": "main", "type": "PREVIOUS_VERSION", "inherited": true},
+		  {"projectKey": "PavedRoad_test123", "branchKey": "release-1.0", "type": "NUMBER_OF_DAYS",
+		   "value": "30", "effectiveValue": "30"}
+		]}`))
+	})
+	defer srv.Close()
+
+	rsp, err := c.ListNewCodePeriods(projectKey)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var l NewCodePeriodListResponse
+	if err = decodeResponse(rsp, &l); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	if len(l.NewCodePeriods) != 2 || !l.NewCodePeriods[0].Inherited || l.NewCodePeriods[1].Value != "30" {
+		t.Errorf("Unexpected periods %v\n", l.NewCodePeriods)
+	}
+}
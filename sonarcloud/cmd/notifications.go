@@ -0,0 +1,125 @@
+package sonarcloud
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// NotificationListResponse for a GET / notifications/list
+type NotificationListResponse struct {
+	Notifications []NotificationObject `json:"notifications"`
+
+	// Channels available to the user
+	Channels []string `json:"channels"`
+
+	// GlobalTypes notification types not tied to a project
+	GlobalTypes []string `json:"globalTypes"`
+
+	// PerProjectTypes notification types a project can be subscribed to
+	PerProjectTypes []string `json:"perProjectTypes"`
+}
+
+// NotificationObject a notification a user is subscribed to
+type NotificationObject struct {
+	// Channel EmailNotificationChannel
+	Channel string `json:"channel"`
+
+	// Type NewIssues, NewAlerts and so on
+	Type string `json:"type"`
+
+	// Organization of the project, empty for global notifications
+	Organization string `json:"organization,omitempty"`
+
+	// Project key, empty for global notifications
+	Project string `json:"project,omitempty"`
+
+	// ProjectName for display
+	ProjectName string `json:"projectName,omitempty"`
+}
+
+// Notification identifies a subscription
+type Notification struct {
+	// Type (required) NotificationNewIssues, NotificationQualityGate ...
+	Type string
+
+	// Project (optional) key, empty for a global notification
+	Project string
+
+	// Login (optional) user to subscribe, defaults to the token owner
+	Login string
+
+	// Channel (optional) defaults to EmailChannel
+	Channel string
+}
+
+func (n Notification) values() (url.Values, error) {
+	if n.Type == "" {
+		return nil, errors.New("notification type is required")
+	}
+
+	data := url.Values{}
+	data.Set("type", n.Type)
+	setIfNotEmpty(data, "project", n.Project)
+	setIfNotEmpty(data, "login", n.Login)
+	setIfNotEmpty(data, "channel", n.Channel)
+
+	return data, nil
+}
+
+// AddNotification subscribe a user to a notification
+//   example: AddNotification(Notification{Type: NotificationNewIssues,
+//		Project: projectKey, Login: "jdoe"})
+func (c *SonarCloudClient) AddNotification(n Notification) (*http.Response, error) {
+	data, err := n.values()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.post(NotificationAdd, data)
+}
+
+// RemoveNotification unsubscribe a user from a notification
+//   example: RemoveNotification(Notification{Type: NotificationNewIssues,
+//		Project: projectKey, Login: "jdoe"})
+func (c *SonarCloudClient) RemoveNotification(n Notification) (*http.Response, error) {
+	data, err := n.values()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.post(NotificationRemove, data)
+}
+
+// GetNotifications list the notifications of a user
+//   example: GetNotifications(login)
+//   login (optional) defaults to the token owner
+//   Unmarshal the response into a NotificationListResponse
+func (c *SonarCloudClient) GetNotifications(login string) (*http.Response, error) {
+	params := url.Values{}
+	setIfNotEmpty(params, "login", login)
+
+	return c.get(NotificationList, params)
+}
+
+// SubscribeProjectOwners subscribe each owner to new issues and
+// quality gate changes on project
+//   example: SubscribeProjectOwners(projectKey, "jdoe", "asmith")
+//   Stops at the first failure
+func (c *SonarCloudClient) SubscribeProjectOwners(project string, logins ...string) error {
+	for _, login := range logins {
+		for _, t := range []string{NotificationNewIssues, NotificationQualityGate} {
+			rsp, err := c.AddNotification(Notification{
+				Type:    t,
+				Project: project,
+				Login:   login,
+			})
+			if err != nil {
+				return err
+			}
+			rsp.Body.Close()
+		}
+	}
+
+	return nil
+}
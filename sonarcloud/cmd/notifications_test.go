@@ -0,0 +1,66 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+)
+
+// TestSubscribeProjectOwners each owner gets new issues and gate changes
+func TestSubscribeProjectOwners(t *testing.T) {
+	var subs []string
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != NotificationAdd || r.FormValue("project") != projectKey {
+			t.Errorf("Unexpected request %v %v\n", r.URL.Path, r.Form)
+		}
+		subs = append(subs, r.FormValue("login")+":"+r.FormValue("type"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer srv.Close()
+
+	if err := c.SubscribeProjectOwners(projectKey, "jdoe", "asmith"); err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	expected := []string{"jdoe:NewIssues", "jdoe:NewAlerts", "asmith:NewIssues", "asmith:NewAlerts"}
+	if len(subs) != len(expected) {
+		t.Fatalf(testErrorMsgValue, expected, subs)
+	}
+	for i := range expected {
+		if subs[i] != expected[i] {
+			t.Errorf(testErrorMsgValue, expected[i], subs[i])
+		}
+	}
+
+	if _, err := c.AddNotification(Notification{Project: projectKey}); err == nil {
+		t.Errorf("Expected error for missing type\n")
+	}
+}
+
+// TestGetNotifications
+func TestGetNotifications(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("login") != "jdoe" {
+			t.Errorf("Unexpected query %v\n", r.URL.Query())
+		}
+		w.Write([]byte(`{"notifications": [{"channel": "EmailNotificationChannel", "type": "NewAlerts",
+		  "organization": "acme-demo", "project": "test123", "projectName": "Test project 123"}],
+		  "channels": ["EmailNotificationChannel"], "globalTypes": ["CeReportTaskFailure"],
+		  "perProjectTypes": ["NewAlerts", "NewIssues"]}`))
+	})
+	defer srv.Close()
+
+	rsp, err := c.GetNotifications("jdoe")
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var nl NotificationListResponse
+	if err = decodeResponse(rsp, &nl); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	if len(nl.Notifications) != 1 || nl.Notifications[0].Type != NotificationQualityGate ||
+		nl.Notifications[0].Channel != EmailChannel {
+		t.Errorf("Unexpected notifications %v\n", nl.Notifications)
+	}
+}
@@ -0,0 +1,188 @@
+package sonarcloud
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// OrganizationSearchOptions used to filter an organization search
+type OrganizationSearchOptions struct {
+	// Organizations (optional) list of organization keys
+	Organizations []string
+
+	// Member (optional) only organizations the current user is a member of
+	Member bool
+
+	// Page (optional) 1-based page number
+	Page int
+
+	// PageSize (optional) number of organizations per page
+	PageSize int
+}
+
+// OrganizationSearchResponse for a GET / search on organizations
+type OrganizationSearchResponse struct {
+	// Paging object
+	Paging PagingObject `json:"paging"`
+
+	// Organizations list
+	Organizations []OrganizationObject `json:"organizations"`
+}
+
+// OrganizationObject an organization
+type OrganizationObject struct {
+	// Key for access this object
+	Key string `json:"key"`
+
+	// Name for display
+	Name string `json:"name"`
+
+	// Description for display
+	Description string `json:"description,omitempty"`
+
+	// URL of the organization home page
+	URL string `json:"url,omitempty"`
+
+	// Avatar image URL
+	Avatar string `json:"avatar,omitempty"`
+}
+
+// OrganizationMembersOptions used to filter organization members
+//   Organization is required
+type OrganizationMembersOptions struct {
+	// Organization (required) is a valid SonarCloud organization
+	Organization string
+
+	// Query (optional) matches login and name
+	Query string
+
+	// Page (optional) 1-based page number
+	Page int
+
+	// PageSize (optional) number of members per page
+	PageSize int
+}
+
+// OrganizationMembersResponse for a GET / search_members
+type OrganizationMembersResponse struct {
+	// Paging object
+	Paging PagingObject `json:"paging"`
+
+	// Users list
+	Users []OrganizationMember `json:"users"`
+}
+
+// OrganizationMember a member of an organization
+type OrganizationMember struct {
+	// Login of the user
+	Login string `json:"login"`
+
+	// Name for display
+	Name string `json:"name"`
+
+	// Avatar hash
+	Avatar string `json:"avatar,omitempty"`
+
+	// GroupCount number of groups the user belongs to
+	GroupCount int `json:"groupCount"`
+}
+
+// SearchOrganizations search organizations
+//   example: SearchOrganizations(OrganizationSearchOptions{Organizations: []string{org}})
+//   Unmarshal the response into an OrganizationSearchResponse
+func (c *SonarCloudClient) SearchOrganizations(o OrganizationSearchOptions) (*http.Response, error) {
+	params := url.Values{}
+	setIfNotEmpty(params, "organizations", strings.Join(o.Organizations, ","))
+	if o.Member {
+		params.Set("member", "true")
+	}
+	setIfNotZero(params, "p", o.Page)
+	setIfNotZero(params, "ps", o.PageSize)
+
+	return c.get(OrganizationSearch, params)
+}
+
+// OrganizationExists return true if org is a SonarCloud organization
+//   example: OrganizationExists(org)
+func (c *SonarCloudClient) OrganizationExists(org string) (bool, error) {
+	if org == "" {
+		return false, errors.New("organization is required")
+	}
+
+	rsp, err := c.SearchOrganizations(OrganizationSearchOptions{
+		Organizations: []string{org},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var result OrganizationSearchResponse
+	if err = decodeResponse(rsp, &result); err != nil {
+		return false, err
+	}
+
+	for _, v := range result.Organizations {
+		if v.Key == org {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// UpdateOrganization update the display attributes of o.Key
+//   example: UpdateOrganization(OrganizationObject{Key: org, Name: n})
+//   Empty fields are left unchanged
+func (c *SonarCloudClient) UpdateOrganization(o OrganizationObject) (*http.Response, error) {
+	if o.Key == "" {
+		return nil, errors.New("organization key is required")
+	}
+
+	data := url.Values{}
+	data.Set("organization", o.Key)
+	setIfNotEmpty(data, "name", o.Name)
+	setIfNotEmpty(data, "description", o.Description)
+	setIfNotEmpty(data, "url", o.URL)
+	setIfNotEmpty(data, "avatar", o.Avatar)
+
+	return c.post(OrganizationUpdate, data)
+}
+
+// SearchOrganizationMembers list members of an organization
+//   example: SearchOrganizationMembers(OrganizationMembersOptions{Organization: org})
+//   Unmarshal the response into an OrganizationMembersResponse
+func (c *SonarCloudClient) SearchOrganizationMembers(o OrganizationMembersOptions) (*http.Response, error) {
+	if o.Organization == "" {
+		return nil, errors.New("organization is required")
+	}
+
+	params := url.Values{}
+	params.Set("organization", o.Organization)
+	setIfNotEmpty(params, "q", o.Query)
+	setIfNotZero(params, "p", o.Page)
+	setIfNotZero(params, "ps", o.PageSize)
+
+	return c.get(OrganizationSearchMembers, params)
+}
+
+// AddOrganizationMember add user login to an organization
+//   example: AddOrganizationMember(org, login)
+func (c *SonarCloudClient) AddOrganizationMember(org, login string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("login", login)
+
+	return c.post(OrganizationAddMember, data)
+}
+
+// RemoveOrganizationMember remove user login from an organization
+//   example: RemoveOrganizationMember(org, login)
+func (c *SonarCloudClient) RemoveOrganizationMember(org, login string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("login", login)
+
+	return c.post(OrganizationRemoveMember, data)
+}
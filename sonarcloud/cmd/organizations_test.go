@@ -0,0 +1,47 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+)
+
+// TestOrganizationExists
+func TestOrganizationExists(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("organizations") == orgname {
+			w.Write([]byte(`{"paging": {"pageIndex": 1, "pageSize": 100, "total": 1},
+			  "organizations": [{"key": "acme-demo", "name": "ACME demo"}]}`))
+			return
+		}
+		w.Write([]byte(`{"paging": {"pageIndex": 1, "pageSize": 100, "total": 0}, "organizations": []}`))
+	})
+	defer srv.Close()
+
+	found, err := c.OrganizationExists(orgname)
+	if err != nil || !found {
+		t.Errorf("Expected %s to exist Got %v %v\n", orgname, found, err)
+	}
+
+	found, err = c.OrganizationExists("no-such-org")
+	if err != nil || found {
+		t.Errorf("Expected no-such-org to not exist Got %v %v\n", found, err)
+	}
+}
+
+// TestAddOrganizationMember
+func TestAddOrganizationMember(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != OrganizationAddMember || r.FormValue("login") != "jdoe" {
+			t.Errorf("Unexpected request %v %v\n", r.URL, r.Form)
+		}
+		w.Write([]byte(`{"user": {"login": "jdoe", "name": "Jane Doe", "groupCount": 1}}`))
+	})
+	defer srv.Close()
+
+	rsp, err := c.AddOrganizationMember(orgname, "jdoe")
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	checkResponseCode(t, http.StatusOK, rsp.StatusCode)
+}
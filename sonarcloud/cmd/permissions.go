@@ -0,0 +1,160 @@
+package sonarcloud
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// PermissionTemplate used to create a permission template
+type PermissionTemplate struct {
+	// Organization (required) is a valid SonarCloud organization
+	Organization string
+
+	// Name (required) friendly name for display
+	Name string
+
+	// Description (optional) for display
+	Description string
+
+	// ProjectKeyPattern (optional) regular expression, new projects
+	// with a matching key get this template applied
+	ProjectKeyPattern string
+}
+
+// PermissionTemplateResponse for a POST / create_template
+type PermissionTemplateResponse struct {
+	PermissionTemplate PermissionTemplateObject `json:"permissionTemplate"`
+}
+
+// PermissionTemplateObject a permission template
+type PermissionTemplateObject struct {
+	// ID for access this object
+	ID string `json:"id"`
+
+	// Name for display
+	Name string `json:"name"`
+
+	// Description for display
+	Description string `json:"description,omitempty"`
+
+	// ProjectKeyPattern applied to new projects
+	ProjectKeyPattern string `json:"projectKeyPattern,omitempty"`
+
+	// CreatedAt date and time
+	CreatedAt string `json:"createdAt"`
+}
+
+// AddUserPermission grant permission on project to user login
+//   example: AddUserPermission(org, project, login, PermissionAdmin)
+//   project (optional) grant an organization permission instead
+func (c *SonarCloudClient) AddUserPermission(org, project, login, permission string) (*http.Response, error) {
+	data := permissionValues(org, project, permission)
+	data.Set("login", login)
+
+	return c.post(PermissionAddUser, data)
+}
+
+// RemoveUserPermission revoke permission on project from user login
+//   example: RemoveUserPermission(org, project, login, PermissionAdmin)
+func (c *SonarCloudClient) RemoveUserPermission(org, project, login, permission string) (*http.Response, error) {
+	data := permissionValues(org, project, permission)
+	data.Set("login", login)
+
+	return c.post(PermissionRemoveUser, data)
+}
+
+// AddGroupPermission grant permission on project to group
+//   example: AddGroupPermission(org, project, "payments", PermissionIssueAdmin)
+//   project (optional) grant an organization permission instead
+func (c *SonarCloudClient) AddGroupPermission(org, project, group, permission string) (*http.Response, error) {
+	data := permissionValues(org, project, permission)
+	data.Set("groupName", group)
+
+	return c.post(PermissionAddGroup, data)
+}
+
+// RemoveGroupPermission revoke permission on project from group
+//   example: RemoveGroupPermission(org, project, "payments", PermissionIssueAdmin)
+func (c *SonarCloudClient) RemoveGroupPermission(org, project, group, permission string) (*http.Response, error) {
+	data := permissionValues(org, project, permission)
+	data.Set("groupName", group)
+
+	return c.post(PermissionRemoveGroup, data)
+}
+
+// GrantProjectToGroup grant every permission in permissions on
+// project to group, typically right after CreateProject
+//   example: GrantProjectToGroup(org, p.Project, "payments",
+//		PermissionBrowse, PermissionCodeViewer, PermissionIssueAdmin)
+//   project is the key given to CreateProject, KeyPrefix is added
+//   Stops at the first permission that can't be granted
+func (c *SonarCloudClient) GrantProjectToGroup(org, project, group string, permissions ...string) error {
+	if project == "" || group == "" {
+		return errors.New("project and group are required")
+	}
+
+	for _, p := range permissions {
+		rsp, err := c.AddGroupPermission(org, KeyPrefix+project, group, p)
+		if err != nil {
+			return fmt.Errorf("grant %s to %s: %v", p, group, err)
+		}
+		rsp.Body.Close()
+	}
+
+	return nil
+}
+
+// CreatePermissionTemplate create a permission template
+//   example: CreatePermissionTemplate(PermissionTemplate{Organization: org, Name: n})
+//   Unmarshal the response into a PermissionTemplateResponse
+func (c *SonarCloudClient) CreatePermissionTemplate(pt PermissionTemplate) (*http.Response, error) {
+	if pt.Organization == "" || pt.Name == "" {
+		return nil, errors.New("organization and name are required")
+	}
+
+	data := url.Values{}
+	data.Set("organization", pt.Organization)
+	data.Set("name", pt.Name)
+	setIfNotEmpty(data, "description", pt.Description)
+	setIfNotEmpty(data, "projectKeyPattern", pt.ProjectKeyPattern)
+
+	return c.post(PermissionCreateTemplate, data)
+}
+
+// AddGroupToTemplate grant permission to group in template
+//   example: AddGroupToTemplate(org, "Payments", "payments", PermissionAdmin)
+//   template is the template name
+func (c *SonarCloudClient) AddGroupToTemplate(org, template, group, permission string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("templateName", template)
+	data.Set("groupName", group)
+	data.Set("permission", permission)
+
+	return c.post(PermissionAddGroupToTemplate, data)
+}
+
+// ApplyPermissionTemplate replace the permissions of project with
+// the ones of template
+//   example: ApplyPermissionTemplate(org, "Payments", project)
+//   template is the template name
+func (c *SonarCloudClient) ApplyPermissionTemplate(org, template, project string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("templateName", template)
+	data.Set("projectKey", project)
+
+	return c.post(PermissionApplyTemplate, data)
+}
+
+// permissionValues parameters common to user and group permissions
+func permissionValues(org, project, permission string) url.Values {
+	v := url.Values{}
+	v.Set("organization", org)
+	v.Set("permission", permission)
+	setIfNotEmpty(v, "projectKey", project)
+
+	return v
+}
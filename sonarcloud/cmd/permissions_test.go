@@ -0,0 +1,58 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+)
+
+// TestGrantProjectToGroup grant several permissions one at a time
+func TestGrantProjectToGroup(t *testing.T) {
+	var granted []string
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != PermissionAddGroup || r.FormValue("projectKey") != KeyPrefix+projectKey ||
+			r.FormValue("groupName") != "payments" {
+			t.Errorf("Unexpected request %v %v\n", r.URL, r.Form)
+		}
+		if r.FormValue("permission") == PermissionAdmin {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors":[{"msg":"Insufficient privileges"}]}`))
+			return
+		}
+		granted = append(granted, r.FormValue("permission"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer srv.Close()
+
+	err := c.GrantProjectToGroup(orgname, projectKey, "payments",
+		PermissionBrowse, PermissionCodeViewer, PermissionIssueAdmin)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	if len(granted) != 3 {
+		t.Errorf(testErrorMsgValue, 3, len(granted))
+	}
+
+	err = c.GrantProjectToGroup(orgname, projectKey, "payments", PermissionAdmin)
+	if err == nil {
+		t.Errorf("Expected error for forbidden permission\n")
+	}
+}
+
+// TestApplyPermissionTemplate
+func TestApplyPermissionTemplate(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("templateName") != "Payments" || r.FormValue("projectKey") != projectKey {
+			t.Errorf("Unexpected form %v\n", r.Form)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer srv.Close()
+
+	rsp, err := c.ApplyPermissionTemplate(orgname, "Payments", projectKey)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	checkResponseCode(t, http.StatusNoContent, rsp.StatusCode)
+}
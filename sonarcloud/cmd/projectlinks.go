@@ -0,0 +1,67 @@
+package sonarcloud
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// ProjectLinkResponse for a POST / project_links/create
+type ProjectLinkResponse struct {
+	Link ProjectLinkObject `json:"link"`
+}
+
+// ProjectLinkSearchResponse for a GET / project_links/search
+type ProjectLinkSearchResponse struct {
+	Links []ProjectLinkObject `json:"links"`
+}
+
+// ProjectLinkObject a link shown on the project dashboard
+type ProjectLinkObject struct {
+	// ID for access this object
+	ID string `json:"id"`
+
+	// Name for display, empty for links provided by SonarCloud
+	Name string `json:"name,omitempty"`
+
+	// Type homepage, ci, issue, scm or custom
+	Type string `json:"type"`
+
+	// URL of the link
+	URL string `json:"url"`
+}
+
+// CreateProjectLink add a link to a project
+//   example: CreateProjectLink(projectKey, "Runbook", "https://wiki.acme.io/payments")
+//   Unmarshal the response into a ProjectLinkResponse
+func (c *SonarCloudClient) CreateProjectLink(project, name, link string) (*http.Response, error) {
+	if name == "" || link == "" {
+		return nil, errors.New("name and url are required")
+	}
+
+	data := url.Values{}
+	data.Set("projectKey", project)
+	data.Set("name", name)
+	data.Set("url", link)
+
+	return c.post(ProjectLinkCreate, data)
+}
+
+// GetProjectLinks list the links of a project
+//   example: GetProjectLinks(projectKey)
+//   Unmarshal the response into a ProjectLinkSearchResponse
+func (c *SonarCloudClient) GetProjectLinks(project string) (*http.Response, error) {
+	params := url.Values{}
+	params.Set("projectKey", project)
+
+	return c.get(ProjectLinkSearch, params)
+}
+
+// DeleteProjectLink delete link id
+//   example: DeleteProjectLink(id)
+func (c *SonarCloudClient) DeleteProjectLink(id string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("id", id)
+
+	return c.post(ProjectLinkDelete, data)
+}
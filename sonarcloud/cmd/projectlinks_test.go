@@ -0,0 +1,35 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+)
+
+// TestCreateProjectLink
+func TestCreateProjectLink(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("projectKey") != projectKey || r.FormValue("name") != "Runbook" {
+			t.Errorf("Unexpected form %v\n", r.Form)
+		}
+		w.Write([]byte(`{"link": {"id": "60", "name": "Runbook", "url": "https://wiki.acme.io/payments"}}`))
+	})
+	defer srv.Close()
+
+	rsp, err := c.CreateProjectLink(projectKey, "Runbook", "https://wiki.acme.io/payments")
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var l ProjectLinkResponse
+	if err = decodeResponse(rsp, &l); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	if l.Link.ID != "60" {
+		t.Errorf(testErrorMsgValue, "60", l.Link.ID)
+	}
+
+	if _, err = c.CreateProjectLink(projectKey, "Runbook", ""); err == nil {
+		t.Errorf("Expected error for missing url\n")
+	}
+}
@@ -0,0 +1,46 @@
+package sonarcloud
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// validTag lowercase letters, digits and -+#. as accepted by SonarCloud
+var validTag = regexp.MustCompile(`^[a-z0-9+#.-]+$`)
+
+// ProjectTagSearchResponse for a GET / project_tags/search
+type ProjectTagSearchResponse struct {
+	Tags []string `json:"tags"`
+}
+
+// SetProjectTags replace the tags of a project
+//   example: SetProjectTags(projectKey, "payments", "go")
+//   No tags removes every tag
+func (c *SonarCloudClient) SetProjectTags(project string, tags ...string) (*http.Response, error) {
+	for _, t := range tags {
+		if !validTag.MatchString(t) {
+			return nil, errors.New("invalid tag: " + t)
+		}
+	}
+
+	data := url.Values{}
+	data.Set("project", project)
+	data.Set("tags", strings.Join(tags, ","))
+
+	return c.post(ProjectTagSet, data)
+}
+
+// SearchProjectTags list tags used in an organization
+//   example: SearchProjectTags(org, query)
+//   query (optional) matches the tag
+//   Unmarshal the response into a ProjectTagSearchResponse
+func (c *SonarCloudClient) SearchProjectTags(org, query string) (*http.Response, error) {
+	params := url.Values{}
+	params.Set("organization", org)
+	setIfNotEmpty(params, "q", query)
+
+	return c.get(ProjectTagSearch, params)
+}
@@ -0,0 +1,27 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+)
+
+// TestSetProjectTags
+func TestSetProjectTags(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("project") != projectKey || r.FormValue("tags") != "payments,go" {
+			t.Errorf("Unexpected form %v\n", r.Form)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer srv.Close()
+
+	rsp, err := c.SetProjectTags(projectKey, "payments", "go")
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	checkResponseCode(t, http.StatusNoContent, rsp.StatusCode)
+
+	if _, err = c.SetProjectTags(projectKey, "Payments Team"); err == nil {
+		t.Errorf("Expected error for invalid tag\n")
+	}
+}
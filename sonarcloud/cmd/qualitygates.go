@@ -0,0 +1,285 @@
+package sonarcloud
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// QualityGateListResponse for a GET / list on quality gates
+type QualityGateListResponse struct {
+	// QualityGates list
+	QualityGates []QualityGateObject `json:"qualitygates"`
+
+	// Default id of the organization default gate
+	Default int64 `json:"default"`
+}
+
+// QualityGateObject a quality gate
+type QualityGateObject struct {
+	// ID for access this object
+	ID int64 `json:"id"`
+
+	// Name for display
+	Name string `json:"name"`
+
+	// IsDefault true for the organization default gate
+	IsDefault bool `json:"isDefault"`
+
+	// IsBuiltIn true for gates provided by SonarCloud
+	IsBuiltIn bool `json:"isBuiltIn"`
+
+	// Conditions list, only included by some calls
+	Conditions []QualityGateCondition `json:"conditions,omitempty"`
+}
+
+// QualityGateCondition a condition on a quality gate
+//   Used to create and update conditions
+type QualityGateCondition struct {
+	// ID of the condition, set by SonarCloud
+	ID int64 `json:"id,omitempty"`
+
+	// GateID the condition belongs to, required on create
+	GateID int64 `json:"-"`
+
+	// Metric key e.g. new_coverage
+	Metric string `json:"metric"`
+
+	// Operator OperatorLessThan or OperatorGreaterThan
+	Operator string `json:"op"`
+
+	// Error threshold
+	Error string `json:"error"`
+}
+
+// ProjectStatusOptions select the analysis to read the gate status for
+//   Project or AnalysisID is required
+type ProjectStatusOptions struct {
+	// Project is the SonarCloud Key
+	Project string
+
+	// Branch (optional) a long living branch
+	Branch string
+
+	// PullRequest (optional) pull request id
+	PullRequest string
+
+	// AnalysisID (optional) a specific analysis
+	AnalysisID string
+}
+
+// ProjectStatusResponse for a GET / project_status
+type ProjectStatusResponse struct {
+	ProjectStatus ProjectStatusObject `json:"projectStatus"`
+}
+
+// ProjectStatusObject quality gate result for an analysis
+type ProjectStatusObject struct {
+	// Status GateOK, GateWarn, GateError or GateNone
+	Status string `json:"status"`
+
+	// Conditions evaluated for the analysis
+	Conditions []ProjectStatusCondition `json:"conditions"`
+
+	// IgnoredConditions true when conditions were ignored
+	// because too few lines changed
+	IgnoredConditions bool `json:"ignoredConditions"`
+}
+
+// ProjectStatusCondition result of a single condition
+type ProjectStatusCondition struct {
+	// Status GateOK or GateError
+	Status string `json:"status"`
+
+	// MetricKey e.g. new_coverage
+	MetricKey string `json:"metricKey"`
+
+	// Comparator OperatorLessThan or OperatorGreaterThan
+	Comparator string `json:"comparator"`
+
+	// PeriodIndex set for conditions on new code
+	PeriodIndex int `json:"periodIndex,omitempty"`
+
+	// ErrorThreshold value that fails the condition
+	ErrorThreshold string `json:"errorThreshold"`
+
+	// ActualValue measured by the analysis
+	ActualValue string `json:"actualValue"`
+}
+
+// Passed return true if the quality gate status is OK
+//   WARN and NONE are not a pass
+func (s ProjectStatusObject) Passed() bool {
+	return s.Status == GateOK
+}
+
+// Failed return the conditions that did not pass
+func (s ProjectStatusObject) Failed() []ProjectStatusCondition {
+	var failed []ProjectStatusCondition
+
+	for _, v := range s.Conditions {
+		if v.Status == GateError {
+			failed = append(failed, v)
+		}
+	}
+
+	return failed
+}
+
+// ListQualityGates list quality gates of an organization
+//   example: ListQualityGates(org)
+//   Unmarshal the response into a QualityGateListResponse
+func (c *SonarCloudClient) ListQualityGates(org string) (*http.Response, error) {
+	params := url.Values{}
+	params.Set("organization", org)
+
+	return c.get(QualityGateList, params)
+}
+
+// CreateQualityGate create a new empty quality gate
+//   example: CreateQualityGate(org, name)
+//   Unmarshal the response into a QualityGateObject
+func (c *SonarCloudClient) CreateQualityGate(org, name string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("name", name)
+
+	return c.post(QualityGateCreate, data)
+}
+
+// CopyQualityGate copy quality gate id and its conditions to name
+//   example: CopyQualityGate(org, id, name)
+//   Unmarshal the response into a QualityGateObject
+func (c *SonarCloudClient) CopyQualityGate(org string, id int64, name string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("id", strconv.FormatInt(id, 10))
+	data.Set("name", name)
+
+	return c.post(QualityGateCopy, data)
+}
+
+// RenameQualityGate rename quality gate id
+//   example: RenameQualityGate(org, id, name)
+func (c *SonarCloudClient) RenameQualityGate(org string, id int64, name string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("id", strconv.FormatInt(id, 10))
+	data.Set("name", name)
+
+	return c.post(QualityGateRename, data)
+}
+
+// CreateQualityGateCondition add a condition to gate qc.GateID
+//   example: CreateQualityGateCondition(org, QualityGateCondition{
+//		GateID: id, Metric: "new_coverage", Operator: OperatorLessThan, Error: "80"})
+//   Unmarshal the response into a QualityGateCondition
+func (c *SonarCloudClient) CreateQualityGateCondition(org string, qc QualityGateCondition) (*http.Response, error) {
+	if qc.GateID == 0 {
+		return nil, errors.New("gate id is required")
+	}
+
+	if err := validateCondition(qc); err != nil {
+		return nil, err
+	}
+
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("gateId", strconv.FormatInt(qc.GateID, 10))
+	data.Set("metric", qc.Metric)
+	data.Set("op", qc.Operator)
+	data.Set("error", qc.Error)
+
+	return c.post(QualityGateCreateCondition, data)
+}
+
+// UpdateQualityGateCondition update condition qc.ID
+//   example: UpdateQualityGateCondition(org, qc)
+func (c *SonarCloudClient) UpdateQualityGateCondition(org string, qc QualityGateCondition) (*http.Response, error) {
+	if qc.ID == 0 {
+		return nil, errors.New("condition id is required")
+	}
+
+	if err := validateCondition(qc); err != nil {
+		return nil, err
+	}
+
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("id", strconv.FormatInt(qc.ID, 10))
+	data.Set("metric", qc.Metric)
+	data.Set("op", qc.Operator)
+	data.Set("error", qc.Error)
+
+	return c.post(QualityGateUpdateCondition, data)
+}
+
+// DeleteQualityGateCondition delete condition id
+//   example: DeleteQualityGateCondition(org, id)
+func (c *SonarCloudClient) DeleteQualityGateCondition(org string, id int64) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("id", strconv.FormatInt(id, 10))
+
+	return c.post(QualityGateDeleteCondition, data)
+}
+
+// SelectQualityGate use quality gate id for project
+//   example: SelectQualityGate(org, id, projectKey)
+func (c *SonarCloudClient) SelectQualityGate(org string, id int64, project string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("gateId", strconv.FormatInt(id, 10))
+	data.Set("projectKey", project)
+
+	return c.post(QualityGateSelect, data)
+}
+
+// DeselectQualityGate make project use the organization default gate
+//   example: DeselectQualityGate(org, projectKey)
+func (c *SonarCloudClient) DeselectQualityGate(org, project string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("projectKey", project)
+
+	return c.post(QualityGateDeselect, data)
+}
+
+// GetProjectStatus read the quality gate status of an analysis
+//   example: GetProjectStatus(ProjectStatusOptions{Project: key, Branch: "main"})
+//   Unmarshal the response into a ProjectStatusResponse
+func (c *SonarCloudClient) GetProjectStatus(o ProjectStatusOptions) (*http.Response, error) {
+	if o.Project == "" && o.AnalysisID == "" {
+		return nil, errors.New("project or analysis id is required")
+	}
+
+	if o.Branch != "" && o.PullRequest != "" {
+		return nil, errors.New("branch and pull request are mutually exclusive")
+	}
+
+	params := url.Values{}
+	setIfNotEmpty(params, "projectKey", o.Project)
+	setIfNotEmpty(params, "branch", o.Branch)
+	setIfNotEmpty(params, "pullRequest", o.PullRequest)
+	setIfNotEmpty(params, "analysisId", o.AnalysisID)
+
+	return c.get(QualityGateProjectStatus, params)
+}
+
+// validateCondition check the fields common to create and update
+func validateCondition(qc QualityGateCondition) error {
+	if qc.Metric == "" {
+		return errors.New("metric is required")
+	}
+
+	if qc.Operator != OperatorLessThan && qc.Operator != OperatorGreaterThan {
+		return errors.New("invalid operator: " + qc.Operator)
+	}
+
+	if qc.Error == "" {
+		return errors.New("error threshold is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,125 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+)
+
+const projectStatusJSON = `{
+  "projectStatus": {
+    "status": "ERROR",
+    "conditions": [
+      {
+        "status": "ERROR",
+        "metricKey": "new_coverage",
+        "comparator": "LT",
+        "periodIndex": 1,
+        "errorThreshold": "80",
+        "actualValue": "62.5"
+      },
+      {
+        "status": "OK",
+        "metricKey": "new_bugs",
+        "comparator": "GT",
+        "periodIndex": 1,
+        "errorThreshold": "0",
+        "actualValue": "0"
+      }
+    ],
+    "ignoredConditions": false
+  }
+}`
+
+// TestGetProjectStatus
+func TestGetProjectStatus(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("projectKey") != projectKey || q.Get("branch") != "release-1.0" {
+			t.Errorf("Unexpected query %v\n", q)
+		}
+		w.Write([]byte(projectStatusJSON))
+	})
+	defer srv.Close()
+
+	rsp, err := c.GetProjectStatus(ProjectStatusOptions{
+		Project: projectKey,
+		Branch:  "release-1.0",
+	})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var ps ProjectStatusResponse
+	if err = decodeResponse(rsp, &ps); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	if ps.ProjectStatus.Passed() {
+		t.Errorf("Expected quality gate to fail\n")
+	}
+
+	failed := ps.ProjectStatus.Failed()
+	if len(failed) != 1 || failed[0].ActualValue != "62.5" || failed[0].ErrorThreshold != "80" {
+		t.Errorf("Unexpected failed conditions %v\n", failed)
+	}
+
+	_, err = c.GetProjectStatus(ProjectStatusOptions{
+		Project:     projectKey,
+		Branch:      "main",
+		PullRequest: "7",
+	})
+	if err == nil {
+		t.Errorf("Expected error for branch and pull request\n")
+	}
+}
+
+// TestCreateQualityGateCondition
+func TestCreateQualityGateCondition(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("gateId") != "9" || r.FormValue("op") != OperatorLessThan {
+			t.Errorf("Unexpected form %v\n", r.Form)
+		}
+		w.Write([]byte(`{"id": 12, "metric": "new_coverage", "op": "LT", "error": "80"}`))
+	})
+	defer srv.Close()
+
+	qc := QualityGateCondition{
+		GateID:   9,
+		Metric:   "new_coverage",
+		Operator: OperatorLessThan,
+		Error:    "80",
+	}
+
+	rsp, err := c.CreateQualityGateCondition(orgname, qc)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var created QualityGateCondition
+	if err = decodeResponse(rsp, &created); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	if created.ID != 12 {
+		t.Errorf(testErrorMsgValue, 12, created.ID)
+	}
+
+	qc.Operator = "EQ"
+	if _, err = c.CreateQualityGateCondition(orgname, qc); err == nil {
+		t.Errorf("Expected error for invalid operator\n")
+	}
+}
+
+// TestProjectStatusPassed only OK is a pass
+func TestProjectStatusPassed(t *testing.T) {
+	for status, expected := range map[string]bool{
+		GateOK:    true,
+		GateWarn:  false,
+		GateNone:  false,
+		GateError: false,
+	} {
+		if got := (ProjectStatusObject{Status: status}).Passed(); got != expected {
+			t.Errorf(testErrorMsgValue, expected, status)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package sonarcloud
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// QualityProfileSearchOptions used to filter a quality profile search
+//   Organization is required
+type QualityProfileSearchOptions struct {
+	// Organization (required) is a valid SonarCloud organization
+	Organization string
+
+	// Language (optional) e.g. go
+	Language string
+
+	// Project (optional) profiles used by this project
+	Project string
+
+	// QualityProfile (optional) profile name
+	QualityProfile string
+
+	// Defaults (optional) only return default profiles
+	Defaults bool
+}
+
+// QualityProfileSearchResponse for a GET / search on quality profiles
+type QualityProfileSearchResponse struct {
+	Profiles []QualityProfileObject `json:"profiles"`
+}
+
+// QualityProfileObject a quality profile
+type QualityProfileObject struct {
+	// Key for access this object
+	Key string `json:"key"`
+
+	// Name for display
+	Name string `json:"name"`
+
+	// Language key
+	Language string `json:"language"`
+
+	// LanguageName for display
+	LanguageName string `json:"languageName"`
+
+	// Organization name
+	Organization string `json:"organization"`
+
+	// IsInherited true if it has a parent profile
+	IsInherited bool `json:"isInherited"`
+
+	// IsDefault true for the language default profile
+	IsDefault bool `json:"isDefault"`
+
+	// IsBuiltIn true for profiles provided by SonarCloud
+	IsBuiltIn bool `json:"isBuiltIn"`
+
+	// ActiveRuleCount number of active rules
+	ActiveRuleCount int `json:"activeRuleCount"`
+
+	// ProjectCount number of projects explicitly using it
+	ProjectCount int `json:"projectCount"`
+
+	// RulesUpdatedAt date and time
+	RulesUpdatedAt string `json:"rulesUpdatedAt"`
+
+	// LastUsed date and time
+	LastUsed string `json:"lastUsed"`
+}
+
+// QualityProfileRestoreResponse for a POST / restore
+type QualityProfileRestoreResponse struct {
+	// Profile that was restored
+	Profile QualityProfileObject `json:"profile"`
+
+	// RuleSuccesses number of rules activated
+	RuleSuccesses int `json:"ruleSuccesses"`
+
+	// RuleFailures number of rules that could not be activated
+	RuleFailures int `json:"ruleFailures"`
+}
+
+// SearchQualityProfiles search quality profiles
+//   example: SearchQualityProfiles(QualityProfileSearchOptions{Organization: org, Language: "go"})
+//   Unmarshal the response into a QualityProfileSearchResponse
+func (c *SonarCloudClient) SearchQualityProfiles(o QualityProfileSearchOptions) (*http.Response, error) {
+	if o.Organization == "" {
+		return nil, errors.New("organization is required")
+	}
+
+	params := url.Values{}
+	params.Set("organization", o.Organization)
+	setIfNotEmpty(params, "language", o.Language)
+	setIfNotEmpty(params, "project", o.Project)
+	setIfNotEmpty(params, "qualityProfile", o.QualityProfile)
+	if o.Defaults {
+		params.Set("defaults", "true")
+	}
+
+	return c.get(QualityProfileSearch, params)
+}
+
+// AddQualityProfileProject use profile for project
+//   example: AddQualityProfileProject(org, "go", "PavedRoad Go", projectKey)
+//   profile is the quality profile name for language
+func (c *SonarCloudClient) AddQualityProfileProject(org, language, profile, project string) (*http.Response, error) {
+	data := profileValues(org, language, profile)
+	data.Set("project", project)
+
+	return c.post(QualityProfileAddProject, data)
+}
+
+// RemoveQualityProfileProject make project use the language default profile
+//   example: RemoveQualityProfileProject(org, "go", "PavedRoad Go", projectKey)
+func (c *SonarCloudClient) RemoveQualityProfileProject(org, language, profile, project string) (*http.Response, error) {
+	data := profileValues(org, language, profile)
+	data.Set("project", project)
+
+	return c.post(QualityProfileRemoveProject, data)
+}
+
+// BackupQualityProfile read a quality profile as XML
+//   example: BackupQualityProfile(org, "go", "PavedRoad Go")
+//   The response body is the XML backup
+func (c *SonarCloudClient) BackupQualityProfile(org, language, profile string) (*http.Response, error) {
+	return c.get(QualityProfileBackup, profileValues(org, language, profile))
+}
+
+// RestoreQualityProfile create or replace a quality profile from
+// an XML backup
+//   example: RestoreQualityProfile(org, xmlReader)
+//   The profile name and language are read from the backup
+//   Unmarshal the response into a QualityProfileRestoreResponse
+func (c *SonarCloudClient) RestoreQualityProfile(org string, backup io.Reader) (*http.Response, error) {
+	fields := url.Values{}
+	fields.Set("organization", org)
+
+	return c.postFile(QualityProfileRestore, fields, "backup", "backup.xml", backup)
+}
+
+// profileValues parameters identifying a quality profile
+func profileValues(org, language, profile string) url.Values {
+	v := url.Values{}
+	v.Set("organization", org)
+	v.Set("language", language)
+	v.Set("qualityProfile", profile)
+
+	return v
+}
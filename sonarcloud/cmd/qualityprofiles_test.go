@@ -0,0 +1,85 @@
+package sonarcloud
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const profileBackupXML = `<?xml version='1.0' encoding='UTF-8'?>
+<profile>
+  <name>PavedRoad Go</name>
+  <language>go</language>
+  <rules>
+    <rule>
+      <repositoryKey>go</repositoryKey>
+      <key>S1234</key>
+      <priority>MAJOR</priority>
+      <parameters/>
+    </rule>
+  </rules>
+</profile>`
+
+// TestBackupQualityProfile
+func TestBackupQualityProfile(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("language") != "go" || q.Get("qualityProfile") != "PavedRoad Go" {
+			t.Errorf("Unexpected query %v\n", q)
+		}
+		w.Write([]byte(profileBackupXML))
+	})
+	defer srv.Close()
+
+	rsp, err := c.BackupQualityProfile(orgname, "go", "PavedRoad Go")
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	xml, err := ioutil.ReadAll(rsp.Body)
+	if err != nil {
+		t.Errorf(testErrorMsg, err)
+	}
+
+	if string(xml) != profileBackupXML {
+		t.Errorf(testErrorMsgValue, profileBackupXML, string(xml))
+	}
+}
+
+// TestRestoreQualityProfile make sure the backup is sent as a file
+func TestRestoreQualityProfile(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("organization") != orgname {
+			t.Errorf(testErrorMsgValue, orgname, r.FormValue("organization"))
+		}
+
+		f, _, err := r.FormFile("backup")
+		if err != nil {
+			t.Errorf(testErrorMsg, err)
+			return
+		}
+		xml, _ := ioutil.ReadAll(f)
+		if string(xml) != profileBackupXML {
+			t.Errorf(testErrorMsgValue, profileBackupXML, string(xml))
+		}
+
+		w.Write([]byte(`{"profile": {"key": "AXP1", "name": "PavedRoad Go", "language": "go"},
+		  "ruleSuccesses": 1, "ruleFailures": 0}`))
+	})
+	defer srv.Close()
+
+	rsp, err := c.RestoreQualityProfile(orgname, strings.NewReader(profileBackupXML))
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var restored QualityProfileRestoreResponse
+	if err = decodeResponse(rsp, &restored); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	if restored.Profile.Name != "PavedRoad Go" || restored.RuleSuccesses != 1 {
+		t.Errorf("Unexpected restore response %v\n", restored)
+	}
+}
@@ -0,0 +1,150 @@
+package sonarcloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// get issue a GET request for uri with optional query parameters
+//   The response is returned as is so callers can unmarshal
+//   it into the matching response structure
+func (c *SonarCloudClient) get(uri string, params url.Values) (*http.Response, error) {
+	return c.do(context.Background(), http.MethodGet, uri, params)
+}
+
+// post issue a POST request for uri
+//   Note SonarCloud expects application/x-www-form-urlencoded
+func (c *SonarCloudClient) post(uri string, data url.Values) (*http.Response, error) {
+	return c.do(context.Background(), http.MethodPost, uri, data)
+}
+
+// do send a request to SonarCloud
+//   GET requests carry params in the query string, POST
+//   requests carry them in a form encoded body
+//   A status code >= 400 returns the body as the error
+func (c *SonarCloudClient) do(ctx context.Context, method, uri string, params url.Values) (*http.Response, error) {
+	var req *http.Request
+	var err error
+
+	endpoint := c.URI + uri
+	encoded := params.Encode()
+
+	if method == http.MethodGet {
+		if encoded != "" {
+			endpoint += "?" + encoded
+		}
+		req, err = http.NewRequestWithContext(ctx, method, endpoint, nil)
+	} else {
+		req, err = http.NewRequestWithContext(ctx, method, endpoint, strings.NewReader(encoded))
+	}
+
+	if err != nil {
+		return HandleHTTPClientError(nil, err)
+	}
+
+	if method != http.MethodGet {
+		req.Header.Add(contentType, wwwForm)
+		req.Header.Add(contentLength, strconv.Itoa(len(encoded)))
+	}
+
+	rsp, err := c.Client.Do(req)
+
+	// There was a problem with the connection
+	if err != nil {
+		return HandleHTTPClientError(rsp, err)
+	}
+
+	// There was a problem with the payload
+	// return errmsg in the body as the error
+	if rsp.StatusCode >= http.StatusBadRequest {
+		errmsg, _ := ioutil.ReadAll(rsp.Body)
+		rsp.Body.Close()
+		return rsp, errors.New(string(errmsg))
+	}
+
+	return rsp, nil
+}
+
+// postFile issue a multipart POST request uploading content as
+// the form file field, fields are sent as regular form values
+func (c *SonarCloudClient) postFile(uri string, fields url.Values, field, filename string, content io.Reader) (*http.Response, error) {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	for k, vs := range fields {
+		for _, v := range vs {
+			if err := w.WriteField(k, v); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	part, err := w.CreateFormFile(field, filename)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = io.Copy(part, content); err != nil {
+		return nil, err
+	}
+
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.URI+uri, body)
+	if err != nil {
+		return HandleHTTPClientError(nil, err)
+	}
+
+	req.Header.Add(contentType, w.FormDataContentType())
+	rsp, err := c.Client.Do(req)
+
+	if err != nil {
+		return HandleHTTPClientError(rsp, err)
+	}
+
+	if rsp.StatusCode >= http.StatusBadRequest {
+		errmsg, _ := ioutil.ReadAll(rsp.Body)
+		rsp.Body.Close()
+		return rsp, errors.New(string(errmsg))
+	}
+
+	return rsp, nil
+}
+
+// decodeResponse unmarshal a JSON response body into v
+//   The body is always closed
+func decodeResponse(rsp *http.Response, v interface{}) error {
+	defer rsp.Body.Close()
+
+	body, err := ioutil.ReadAll(rsp.Body)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, v)
+}
+
+// setIfNotEmpty add a form or query parameter only when it has a value
+func setIfNotEmpty(v url.Values, key, value string) {
+	if value != "" {
+		v.Set(key, value)
+	}
+}
+
+// setIfNotZero add a numeric form or query parameter only when it is set
+func setIfNotZero(v url.Values, key string, value int) {
+	if value != 0 {
+		v.Set(key, strconv.Itoa(value))
+	}
+}
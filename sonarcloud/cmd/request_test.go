@@ -0,0 +1,78 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+// newTestServer start a TLS server using h and return a client
+// that talks to it
+func newTestServer(t *testing.T, h http.HandlerFunc) (*SonarCloudClient, *httptest.Server) {
+	srv := httptest.NewTLSServer(h)
+
+	c := &SonarCloudClient{
+		Host: srv.Listener.Addr().String(),
+	}
+
+	if err := c.New("fake", 1); err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	c.Client = srv.Client()
+
+	return c, srv
+}
+
+// TestRequestGet check query parameters and authentication
+func TestRequestGet(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if user, _, ok := r.BasicAuth(); !ok || user != "fake" {
+			t.Errorf(testErrorMsgValue, "fake", user)
+		}
+		if r.URL.Path != ProjectSearch {
+			t.Errorf(testErrorMsgValue, ProjectSearch, r.URL.Path)
+		}
+		if r.URL.Query().Get("projects") != projectKey {
+			t.Errorf(testErrorMsgValue, projectKey, r.URL.Query().Get("projects"))
+		}
+		w.Write([]byte("{}"))
+	})
+	defer srv.Close()
+
+	params := url.Values{}
+	params.Set("projects", projectKey)
+	rsp, err := c.get(ProjectSearch, params)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	checkResponseCode(t, http.StatusOK, rsp.StatusCode)
+}
+
+// TestRequestPost check the form body and error handling
+func TestRequestPost(t *testing.T) {
+	expected := `{"errors":[{"msg":"Could not create Project"}]}`
+
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(contentType) != wwwForm {
+			t.Errorf(testErrorMsgValue, wwwForm, r.Header.Get(contentType))
+		}
+		if r.FormValue("name") != projectName {
+			t.Errorf(testErrorMsgValue, projectName, r.FormValue("name"))
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(expected))
+	})
+	defer srv.Close()
+
+	data := url.Values{}
+	data.Set("name", projectName)
+	rsp, err := c.post(ProjectCreate, data)
+	if err == nil || !strings.Contains(err.Error(), "Could not create") {
+		t.Errorf(testErrorMsgValue, expected, err)
+	}
+
+	checkResponseCode(t, http.StatusBadRequest, rsp.StatusCode)
+}
@@ -0,0 +1,195 @@
+package sonarcloud
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SecretSink stores a token where its consumers read it, e.g.
+// a CI secret or a vault entry
+type SecretSink interface {
+	// Store save token under name, replacing the current value
+	Store(name, token string) error
+
+	// Rollback restore the value replaced by the last Store
+	Rollback() error
+}
+
+// TokenRotation describes the token to rotate
+type TokenRotation struct {
+	// Name (required) base name, tokens are named Name-v1, Name-v2 ...
+	Name string
+
+	// Type (optional) UserToken, ProjectAnalysisToken or GlobalAnalysisToken
+	Type string
+
+	// ProjectKey (required) for a ProjectAnalysisToken
+	ProjectKey string
+
+	// Lifetime (optional) the new token expires after it
+	Lifetime time.Duration
+
+	// Sink (required) receives the new token
+	Sink SecretSink
+}
+
+// RotationResult names of the revoked and created tokens
+type RotationResult struct {
+	// OldName revoked token, empty if there was none
+	OldName string
+
+	// NewName created token
+	NewName string
+}
+
+// ValidateResponse for a GET / authentication/validate
+type ValidateResponse struct {
+	Valid bool `json:"valid"`
+}
+
+// ValidateToken return true if the client token authenticates
+//   example: ValidateToken()
+func (c *SonarCloudClient) ValidateToken() (bool, error) {
+	rsp, err := c.get(AuthValidate, nil)
+	if err != nil {
+		return false, err
+	}
+
+	var v ValidateResponse
+	if err = decodeResponse(rsp, &v); err != nil {
+		return false, err
+	}
+
+	return v.Valid, nil
+}
+
+// RotateToken replace the current version of a token of the
+// current user
+//   example: RotateToken(TokenRotation{Name: "ci", Sink: vault})
+//
+//   Steps
+//		create Name-vN+1
+//		hand it to Sink
+//		verify it authenticates
+//		revoke Name-vN
+//   If a step fails the new token is revoked and Sink is rolled
+//   back, leaving the old token in use
+func (c *SonarCloudClient) RotateToken(r TokenRotation) (*RotationResult, error) {
+	if r.Name == "" || r.Sink == nil {
+		return nil, errors.New("name and sink are required")
+	}
+
+	rsp, err := c.GetTokens("")
+	if err != nil {
+		return nil, err
+	}
+
+	var tr GetTokenResponse
+	if err = decodeResponse(rsp, &tr); err != nil {
+		return nil, err
+	}
+
+	result := &RotationResult{}
+	version := 0
+	for _, t := range tr.Tokens {
+		if v, ok := tokenVersion(r.Name, t.Name); ok && (result.OldName == "" || v > version) {
+			result.OldName = t.Name
+			version = v
+		}
+	}
+
+	result.NewName = fmt.Sprintf("%s-v%d", r.Name, version+1)
+
+	nt := NewToken{
+		Name:       result.NewName,
+		Type:       r.Type,
+		ProjectKey: r.ProjectKey,
+	}
+	if r.Lifetime > 0 {
+		nt.ExpirationDate = timeNow().Add(r.Lifetime)
+	}
+
+	rsp, err = c.CreateTokenWithOptions(nt)
+	if err != nil {
+		return nil, fmt.Errorf("create %s: %v", result.NewName, err)
+	}
+
+	var created NewTokenResponse
+	if err = decodeResponse(rsp, &created); err != nil {
+		return nil, c.rollbackRotation(result.NewName, nil, err)
+	}
+
+	if created.Token == "" {
+		return nil, c.rollbackRotation(result.NewName, nil, errors.New("create "+result.NewName+": empty token"))
+	}
+
+	if err = r.Sink.Store(result.NewName, created.Token); err != nil {
+		return nil, c.rollbackRotation(result.NewName, nil, fmt.Errorf("store: %v", err))
+	}
+
+	verifier := *c
+	verifier.Token = created.Token
+	verifier.URI = fmt.Sprintf("%s%s@%s", DefaultScheme, verifier.Token, verifier.Host)
+
+	valid, err := verifier.ValidateToken()
+	if err == nil && !valid {
+		err = errors.New("new token does not authenticate")
+	}
+	if err != nil {
+		return nil, c.rollbackRotation(result.NewName, r.Sink, fmt.Errorf("verify: %v", err))
+	}
+
+	if result.OldName != "" {
+		if rsp, err = c.RevokeToken(result.OldName); err != nil {
+			return nil, c.rollbackRotation(result.NewName, r.Sink,
+				fmt.Errorf("revoke %s: %v", result.OldName, err))
+		}
+		rsp.Body.Close()
+	}
+
+	return result, nil
+}
+
+// rollbackRotation undo a failed rotation and return an error
+// describing both the failure and any rollback problem
+func (c *SonarCloudClient) rollbackRotation(newName string, sink SecretSink, cause error) error {
+	msgs := []string{cause.Error()}
+
+	if sink != nil {
+		if err := sink.Rollback(); err != nil {
+			msgs = append(msgs, "sink rollback: "+err.Error())
+		}
+	}
+
+	if rsp, err := c.RevokeToken(newName); err != nil {
+		msgs = append(msgs, "revoke "+newName+": "+err.Error())
+	} else {
+		rsp.Body.Close()
+	}
+
+	return errors.New("token rotation failed: " + strings.Join(msgs, "; "))
+}
+
+// tokenVersion return the version of token name for base, base
+// itself is version 0
+func tokenVersion(base, name string) (int, bool) {
+	if name == base {
+		return 0, true
+	}
+
+	m := regexp.MustCompile("^" + regexp.QuoteMeta(base) + `-v(\d+)$`).FindStringSubmatch(name)
+	if m == nil {
+		return 0, false
+	}
+
+	v, err := strconv.Atoi(m[1])
+	if err != nil {
+		return 0, false
+	}
+
+	return v, true
+}
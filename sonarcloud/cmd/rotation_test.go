@@ -0,0 +1,202 @@
+package sonarcloud
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+// fakeSink a SecretSink keeping values in memory
+type fakeSink struct {
+	value    string
+	previous string
+	fail     bool
+}
+
+func (s *fakeSink) Store(name, token string) error {
+	if s.fail {
+		return errors.New("sink unavailable")
+	}
+	s.previous, s.value = s.value, token
+	return nil
+}
+
+func (s *fakeSink) Rollback() error {
+	s.value = s.previous
+	return nil
+}
+
+// tokenServer a minimal user_tokens implementation
+type tokenServer struct {
+	mu      sync.Mutex
+	tokens  map[string]string
+	invalid bool
+
+	// fail answer the next request to a path with this status
+	fail map[string]int
+
+	// empty generate tokens without a value
+	empty bool
+}
+
+func (ts *tokenServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if status, ok := ts.fail[r.URL.Path]; ok {
+		delete(ts.fail, r.URL.Path)
+		w.WriteHeader(status)
+		fmt.Fprintf(w, `{"errors":[{"msg":"%s"}]}`, http.StatusText(status))
+		return
+	}
+
+	switch r.URL.Path {
+	case TokenSearch:
+		tr := GetTokenResponse{Login: "ci-bot"}
+		for name := range ts.tokens {
+			tr.Tokens = append(tr.Tokens, GetTokenItem{Name: name})
+		}
+		json.NewEncoder(w).Encode(tr)
+	case TokenCreate:
+		name := r.FormValue("name")
+		ts.tokens[name] = "secret-" + name
+		if ts.empty {
+			json.NewEncoder(w).Encode(NewTokenResponse{Name: name})
+			return
+		}
+		json.NewEncoder(w).Encode(NewTokenResponse{Name: name, Token: ts.tokens[name]})
+	case TokenRevoke:
+		delete(ts.tokens, r.FormValue("name"))
+		w.WriteHeader(http.StatusNoContent)
+	case AuthValidate:
+		user, _, _ := r.BasicAuth()
+		valid := false
+		for _, v := range ts.tokens {
+			valid = valid || v == user
+		}
+		fmt.Fprintf(w, `{"valid": %v}`, valid && !ts.invalid)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// TestRotateToken
+func TestRotateToken(t *testing.T) {
+	ts := &tokenServer{tokens: map[string]string{
+		"ci-v1": "secret-ci-v1",
+		"ci-v2": "secret-ci-v2",
+		"other": "secret-other",
+	}}
+	c, srv := newTestServer(t, ts.ServeHTTP)
+	defer srv.Close()
+
+	sink := &fakeSink{value: "secret-ci-v2"}
+	result, err := c.RotateToken(TokenRotation{Name: "ci", Sink: sink})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	if result.OldName != "ci-v2" || result.NewName != "ci-v3" {
+		t.Errorf("Unexpected result %v\n", result)
+	}
+
+	if sink.value != "secret-ci-v3" {
+		t.Errorf(testErrorMsgValue, "secret-ci-v3", sink.value)
+	}
+
+	if _, ok := ts.tokens["ci-v2"]; ok {
+		t.Errorf("Expected ci-v2 to be revoked\n")
+	}
+
+	if _, ok := ts.tokens["ci-v1"]; !ok {
+		t.Errorf("Expected ci-v1 to be kept\n")
+	}
+}
+
+// TestRotateTokenRollback
+func TestRotateTokenRollback(t *testing.T) {
+	ts := &tokenServer{tokens: map[string]string{"ci": "secret-ci"}}
+	c, srv := newTestServer(t, ts.ServeHTTP)
+	defer srv.Close()
+
+	// The sink refuses the new token
+	sink := &fakeSink{value: "secret-ci", fail: true}
+	if _, err := c.RotateToken(TokenRotation{Name: "ci", Sink: sink}); err == nil {
+		t.Errorf("Expected error from sink\n")
+	}
+
+	// The new token does not authenticate
+	sink.fail = false
+	ts.invalid = true
+	if _, err := c.RotateToken(TokenRotation{Name: "ci", Sink: sink}); err == nil {
+		t.Errorf("Expected error from verification\n")
+	}
+
+	if sink.value != "secret-ci" {
+		t.Errorf(testErrorMsgValue, "secret-ci", sink.value)
+	}
+
+	if len(ts.tokens) != 1 || ts.tokens["ci"] != "secret-ci" {
+		t.Errorf("Expected only the old token to remain Got %v\n", ts.tokens)
+	}
+}
+
+// TestRotateTokenHTTPErrors failures other than 400 stop the rotation
+func TestRotateTokenHTTPErrors(t *testing.T) {
+	tests := []struct {
+		path   string
+		status int
+	}{
+		{TokenCreate, http.StatusUnauthorized},
+		{TokenCreate, http.StatusForbidden},
+		{TokenCreate, http.StatusInternalServerError},
+		{TokenRevoke, http.StatusForbidden},
+		{TokenRevoke, http.StatusServiceUnavailable},
+	}
+
+	for _, tc := range tests {
+		ts := &tokenServer{
+			tokens: map[string]string{"ci": "secret-ci"},
+			fail:   map[string]int{tc.path: tc.status},
+		}
+		c, srv := newTestServer(t, ts.ServeHTTP)
+
+		sink := &fakeSink{value: "secret-ci"}
+		if _, err := c.RotateToken(TokenRotation{Name: "ci", Sink: sink}); err == nil {
+			t.Errorf("Expected error for %s %d\n", tc.path, tc.status)
+		}
+
+		if sink.value != "secret-ci" {
+			t.Errorf(testErrorMsgValue, "secret-ci", sink.value)
+		}
+
+		if len(ts.tokens) != 1 || ts.tokens["ci"] != "secret-ci" {
+			t.Errorf("Expected only the old token to remain Got %v\n", ts.tokens)
+		}
+
+		srv.Close()
+	}
+}
+
+// TestRotateTokenEmpty an empty token never reaches the sink
+func TestRotateTokenEmpty(t *testing.T) {
+	ts := &tokenServer{tokens: map[string]string{"ci": "secret-ci"}, empty: true}
+	c, srv := newTestServer(t, ts.ServeHTTP)
+	defer srv.Close()
+
+	sink := &fakeSink{value: "secret-ci"}
+	if _, err := c.RotateToken(TokenRotation{Name: "ci", Sink: sink}); err == nil {
+		t.Errorf("Expected error for empty token\n")
+	}
+
+	if sink.value != "secret-ci" || sink.previous != "" {
+		t.Errorf("Expected sink to be untouched Got %v\n", sink)
+	}
+
+	if _, ok := ts.tokens["ci-v1"]; ok {
+		t.Errorf("Expected ci-v1 to be revoked\n")
+	}
+}
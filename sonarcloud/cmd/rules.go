@@ -0,0 +1,204 @@
+package sonarcloud
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// RuleSearchOptions used to filter a rule search
+//   Organization is required
+type RuleSearchOptions struct {
+	// Organization (required) is a valid SonarCloud organization
+	Organization string
+
+	// Query (optional) matches rule name and key
+	Query string
+
+	// Languages (optional) e.g. go, js
+	Languages []string
+
+	// Types (optional) BUG, VULNERABILITY, CODE_SMELL, SECURITY_HOTSPOT
+	Types []string
+
+	// Severities (optional) INFO, MINOR, MAJOR, CRITICAL, BLOCKER
+	Severities []string
+
+	// RuleKey (optional) exact rule key
+	RuleKey string
+
+	// Page (optional) 1-based page number
+	Page int
+
+	// PageSize (optional) number of rules per page
+	PageSize int
+}
+
+// RuleSearchResponse for a GET / search on rules
+type RuleSearchResponse struct {
+	// Total number of rules matching the search
+	Total int `json:"total"`
+
+	// Page number
+	Page int `json:"p"`
+
+	// PageSize elements on this page
+	PageSize int `json:"ps"`
+
+	// Rules list
+	Rules []RuleObject `json:"rules"`
+}
+
+// RuleShowResponse for a GET / show on a rule
+type RuleShowResponse struct {
+	Rule RuleObject `json:"rule"`
+}
+
+// RuleObject rule metadata
+type RuleObject struct {
+	// Key for access this object e.g. go:S1234
+	Key string `json:"key"`
+
+	// Repository the rule belongs to
+	Repository string `json:"repo"`
+
+	// Name for display
+	Name string `json:"name"`
+
+	// Type BUG, VULNERABILITY, CODE_SMELL or SECURITY_HOTSPOT
+	Type string `json:"type"`
+
+	// Severity INFO, MINOR, MAJOR, CRITICAL or BLOCKER
+	Severity string `json:"severity"`
+
+	// Status READY, BETA or DEPRECATED
+	Status string `json:"status"`
+
+	// Language key
+	Language string `json:"lang"`
+
+	// LanguageName for display
+	LanguageName string `json:"langName"`
+
+	// HTMLDescription rule description in HTML
+	HTMLDescription string `json:"htmlDesc"`
+
+	// Tags set by the organization
+	Tags []string `json:"tags"`
+
+	// SysTags set by SonarCloud
+	SysTags []string `json:"sysTags"`
+
+	// RemediationFunction CONSTANT_ISSUE, LINEAR or LINEAR_OFFSET
+	RemediationFunction string `json:"remFnType"`
+
+	// RemediationBaseEffort e.g. 5min
+	RemediationBaseEffort string `json:"remFnBaseEffort"`
+
+	// RemediationGapMultiplier effort per unit of gap
+	RemediationGapMultiplier string `json:"remFnGapMultiplier"`
+
+	// GapDescription explains the gap unit
+	GapDescription string `json:"gapDescription"`
+
+	// CreatedAt date and time
+	CreatedAt string `json:"createdAt"`
+}
+
+// SearchRules search rules
+//   example: SearchRules(RuleSearchOptions{Organization: org, Languages: []string{"go"}})
+//   Unmarshal the response into a RuleSearchResponse
+func (c *SonarCloudClient) SearchRules(o RuleSearchOptions) (*http.Response, error) {
+	if o.Organization == "" {
+		return nil, errors.New("organization is required")
+	}
+
+	params := url.Values{}
+	params.Set("organization", o.Organization)
+	setIfNotEmpty(params, "q", o.Query)
+	setIfNotEmpty(params, "languages", strings.Join(o.Languages, ","))
+	setIfNotEmpty(params, "types", strings.Join(o.Types, ","))
+	setIfNotEmpty(params, "severities", strings.Join(o.Severities, ","))
+	setIfNotEmpty(params, "rule_key", o.RuleKey)
+	setIfNotZero(params, "p", o.Page)
+	setIfNotZero(params, "ps", o.PageSize)
+
+	return c.get(RuleSearch, params)
+}
+
+// GetRule read a single rule
+//   example: GetRule(org, "go:S1234")
+//   Unmarshal the response into a RuleShowResponse
+func (c *SonarCloudClient) GetRule(org, key string) (*http.Response, error) {
+	params := url.Values{}
+	params.Set("organization", org)
+	params.Set("key", key)
+
+	return c.get(RuleShow, params)
+}
+
+// RuleCache keeps rules already read from SonarCloud
+//   Safe for concurrent use, create it with NewRuleCache
+type RuleCache struct {
+	client       *SonarCloudClient
+	organization string
+
+	mu    sync.Mutex
+	rules map[string]RuleObject
+}
+
+// NewRuleCache create an empty rule cache for an organization
+func NewRuleCache(c *SonarCloudClient, org string) *RuleCache {
+	return &RuleCache{
+		client:       c,
+		organization: org,
+		rules:        make(map[string]RuleObject),
+	}
+}
+
+// Get return the rule for key
+//   The rule is read from SonarCloud only the first time
+func (rc *RuleCache) Get(key string) (RuleObject, error) {
+	rc.mu.Lock()
+	r, ok := rc.rules[key]
+	rc.mu.Unlock()
+
+	if ok {
+		return r, nil
+	}
+
+	rsp, err := rc.client.GetRule(rc.organization, key)
+	if err != nil {
+		return RuleObject{}, err
+	}
+
+	var show RuleShowResponse
+	if err = decodeResponse(rsp, &show); err != nil {
+		return RuleObject{}, err
+	}
+
+	rc.Add(show.Rule)
+
+	return show.Rule, nil
+}
+
+// Add store rules, e.g. from a SearchRules response, so
+// later calls to Get don't hit the API
+func (rc *RuleCache) Add(rules ...RuleObject) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	for _, r := range rules {
+		rc.rules[r.Key] = r
+	}
+}
+
+// Len return the number of cached rules
+func (rc *RuleCache) Len() int {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	return len(rc.rules)
+}
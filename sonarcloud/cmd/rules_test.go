@@ -0,0 +1,85 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+)
+
+const ruleShowJSON = `{
+  "rule": {
+    "key": "go:S1234",
+    "repo": "go",
+    "name": "Functions should not be empty",
+    "type": "CODE_SMELL",
+    "severity": "MAJOR",
+    "status": "READY",
+    "lang": "go",
+    "langName": "Go",
+    "htmlDesc": "<p>Empty functions are confusing.</p>",
+    "remFnType": "CONSTANT_ISSUE",
+    "remFnBaseEffort": "5min"
+  }
+}`
+
+// TestSearchRules
+func TestSearchRules(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("organization") != orgname || q.Get("languages") != "go,js" {
+			t.Errorf("Unexpected query %v\n", q)
+		}
+		w.Write([]byte(`{"total": 0, "p": 1, "ps": 100, "rules": []}`))
+	})
+	defer srv.Close()
+
+	rsp, err := c.SearchRules(RuleSearchOptions{
+		Organization: orgname,
+		Languages:    []string{"go", "js"},
+	})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var rs RuleSearchResponse
+	if err = decodeResponse(rsp, &rs); err != nil {
+		t.Errorf(testMarshalFail, err)
+	}
+
+	if _, err = c.SearchRules(RuleSearchOptions{}); err == nil {
+		t.Errorf("Expected error for missing organization\n")
+	}
+}
+
+// TestRuleCache make sure a rule is only read once
+func TestRuleCache(t *testing.T) {
+	calls := 0
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Path != RuleShow || r.URL.Query().Get("key") != "go:S1234" {
+			t.Errorf("Unexpected request %v\n", r.URL)
+		}
+		w.Write([]byte(ruleShowJSON))
+	})
+	defer srv.Close()
+
+	rc := NewRuleCache(c, orgname)
+
+	for i := 0; i < 3; i++ {
+		rule, err := rc.Get("go:S1234")
+		if err != nil {
+			t.Fatalf(testErrorMsg, err)
+		}
+		if rule.Name != "Functions should not be empty" || rule.RemediationBaseEffort != "5min" {
+			t.Errorf("Unexpected rule %v\n", rule)
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf(testErrorMsgValue, 1, calls)
+	}
+
+	rc.Add(RuleObject{Key: "go:S100"})
+	if rc.Len() != 2 {
+		t.Errorf(testErrorMsgValue, 2, rc.Len())
+	}
+}
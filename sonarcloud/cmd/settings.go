@@ -0,0 +1,197 @@
+package sonarcloud
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// SettingValuesResponse for a GET / settings/values
+type SettingValuesResponse struct {
+	Settings []Setting `json:"settings"`
+}
+
+// Setting a single, multi-value or property set setting
+//   Exactly one of Value, Values or FieldValues is set
+type Setting struct {
+	// Key e.g. sonar.exclusions
+	Key string `json:"key"`
+
+	// Value of a single value setting
+	Value string `json:"value,omitempty"`
+
+	// Values of a multi-value setting
+	Values []string `json:"values,omitempty"`
+
+	// FieldValues of a property set setting
+	FieldValues []map[string]string `json:"fieldValues,omitempty"`
+
+	// Inherited true if the value comes from the organization
+	// or the default, only set in responses
+	Inherited bool `json:"inherited,omitempty"`
+}
+
+// empty return true if no value is set
+func (s Setting) empty() bool {
+	return s.Value == "" && len(s.Values) == 0 && len(s.FieldValues) == 0
+}
+
+// sameValue return true if s and o hold the same value
+func (s Setting) sameValue(o Setting) bool {
+	return s.Value == o.Value &&
+		reflect.DeepEqual(normalizeValues(s.Values), normalizeValues(o.Values)) &&
+		reflect.DeepEqual(normalizeFieldValues(s.FieldValues), normalizeFieldValues(o.FieldValues))
+}
+
+// GetSettings read settings of a project
+//   example: GetSettings(projectKey, "sonar.exclusions", "sonar.coverage.exclusions")
+//   component (optional) read organization settings instead
+//   keys (optional) only return these settings
+//   Unmarshal the response into a SettingValuesResponse
+func (c *SonarCloudClient) GetSettings(component string, keys ...string) (*http.Response, error) {
+	params := url.Values{}
+	setIfNotEmpty(params, "component", component)
+	setIfNotEmpty(params, "keys", strings.Join(keys, ","))
+
+	return c.get(SettingValues, params)
+}
+
+// SetSetting set a setting of a project
+//   example: SetSetting(projectKey, Setting{Key: "sonar.exclusions",
+//		Values: []string{"**/vendor/**", "**/*_test.go"}})
+func (c *SonarCloudClient) SetSetting(component string, s Setting) (*http.Response, error) {
+	set := 0
+	if s.Value != "" {
+		set++
+	}
+	if len(s.Values) > 0 {
+		set++
+	}
+	if len(s.FieldValues) > 0 {
+		set++
+	}
+
+	if s.Key == "" || set != 1 {
+		return nil, errors.New("key and exactly one of value, values or field values are required")
+	}
+
+	data := url.Values{}
+	data.Set("key", s.Key)
+	setIfNotEmpty(data, "component", component)
+	setIfNotEmpty(data, "value", s.Value)
+	for _, v := range s.Values {
+		data.Add("values", v)
+	}
+	for _, fv := range s.FieldValues {
+		b, err := json.Marshal(fv)
+		if err != nil {
+			return nil, err
+		}
+		data.Add("fieldValues", string(b))
+	}
+
+	return c.post(SettingSet, data)
+}
+
+// ResetSettings remove project values so the inherited ones apply
+//   example: ResetSettings(projectKey, "sonar.exclusions")
+func (c *SonarCloudClient) ResetSettings(component string, keys ...string) (*http.Response, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one key is required")
+	}
+
+	data := url.Values{}
+	data.Set("keys", strings.Join(keys, ","))
+	setIfNotEmpty(data, "component", component)
+
+	return c.post(SettingReset, data)
+}
+
+// ApplySettings make the settings of component match desired
+//   example: ApplySettings(projectKey, []Setting{
+//		{Key: "sonar.exclusions", Values: []string{"**/vendor/**"}},
+//		{Key: "sonar.coverage.exclusions"}})
+//
+//   A setting is only changed when its project value differs,
+//   inherited values are replaced by a project value. A setting
+//   without a value is reset. Keys not in desired are left alone.
+//   The keys that were changed are returned
+func (c *SonarCloudClient) ApplySettings(component string, desired []Setting) ([]string, error) {
+	keys := make([]string, 0, len(desired))
+	for _, s := range desired {
+		keys = append(keys, s.Key)
+	}
+
+	rsp, err := c.GetSettings(component, keys...)
+	if err != nil {
+		return nil, err
+	}
+
+	var current SettingValuesResponse
+	if err = decodeResponse(rsp, &current); err != nil {
+		return nil, err
+	}
+
+	// Only values set on the component itself count
+	own := make(map[string]Setting)
+	for _, s := range current.Settings {
+		if !s.Inherited {
+			own[s.Key] = s
+		}
+	}
+
+	var changed []string
+	var reset []string
+
+	for _, s := range desired {
+		cur, ok := own[s.Key]
+
+		if s.empty() {
+			if ok {
+				reset = append(reset, s.Key)
+			}
+			continue
+		}
+
+		if ok && cur.sameValue(s) {
+			continue
+		}
+
+		rsp, err = c.SetSetting(component, s)
+		if err != nil {
+			return changed, errors.New("set " + s.Key + ": " + err.Error())
+		}
+		rsp.Body.Close()
+		changed = append(changed, s.Key)
+	}
+
+	if len(reset) > 0 {
+		rsp, err = c.ResetSettings(component, reset...)
+		if err != nil {
+			return changed, errors.New("reset " + strings.Join(reset, ",") + ": " + err.Error())
+		}
+		rsp.Body.Close()
+		changed = append(changed, reset...)
+	}
+
+	return changed, nil
+}
+
+// normalizeValues treat nil and empty lists alike
+func normalizeValues(v []string) []string {
+	if len(v) == 0 {
+		return nil
+	}
+	return v
+}
+
+// normalizeFieldValues treat nil and empty lists alike
+func normalizeFieldValues(v []map[string]string) []map[string]string {
+	if len(v) == 0 {
+		return nil
+	}
+	return v
+}
@@ -0,0 +1,90 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+)
+
+const settingValuesJSON = `{
+  "settings": [
+    {"key": "sonar.exclusions", "values": ["**/vendor/**", "**/*.pb.go"]},
+    {"key": "sonar.coverage.exclusions", "values": ["**/*_test.go"]},
+    {"key": "sonar.test.inclusions", "values": ["**/*_test.go"], "inherited": true},
+    {"key": "sonar.cpd.exclusions", "values": ["**/mocks/**"]},
+    {"key": "sonar.issue.ignore.multicriteria", "fieldValues": [
+      {"ruleKey": "go:S100", "resourceKey": "**/generated/**"}
+    ]}
+  ]
+}`
+
+// TestApplySettings only the settings that differ are changed
+func TestApplySettings(t *testing.T) {
+	var set, reset []string
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case SettingValues:
+			if r.URL.Query().Get("component") != projectKey {
+				t.Errorf("Unexpected query %v\n", r.URL.Query())
+			}
+			w.Write([]byte(settingValuesJSON))
+		case SettingSet:
+			r.ParseForm()
+			set = append(set, r.FormValue("key"))
+			if r.FormValue("key") == "sonar.exclusions" && len(r.Form["values"]) != 3 {
+				t.Errorf("Unexpected values %v\n", r.Form["values"])
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case SettingReset:
+			reset = strings.Split(r.FormValue("keys"), ",")
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	defer srv.Close()
+
+	changed, err := c.ApplySettings(projectKey, []Setting{
+		// differs
+		{Key: "sonar.exclusions", Values: []string{"**/vendor/**", "**/*.pb.go", "**/mocks/**"}},
+		// same
+		{Key: "sonar.coverage.exclusions", Values: []string{"**/*_test.go"}},
+		// same but inherited
+		{Key: "sonar.test.inclusions", Values: []string{"**/*_test.go"}},
+		// reset
+		{Key: "sonar.cpd.exclusions"},
+		// same property set
+		{Key: "sonar.issue.ignore.multicriteria", FieldValues: []map[string]string{
+			{"resourceKey": "**/generated/**", "ruleKey": "go:S100"},
+		}},
+	})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	sort.Strings(set)
+	if strings.Join(set, ",") != "sonar.exclusions,sonar.test.inclusions" {
+		t.Errorf("Unexpected settings set %v\n", set)
+	}
+
+	if len(reset) != 1 || reset[0] != "sonar.cpd.exclusions" {
+		t.Errorf("Unexpected settings reset %v\n", reset)
+	}
+
+	if len(changed) != 3 {
+		t.Errorf("Unexpected changed settings %v\n", changed)
+	}
+}
+
+// TestSetSettingValidation
+func TestSetSettingValidation(t *testing.T) {
+	var c SonarCloudClient
+
+	if _, err := c.SetSetting(projectKey, Setting{Key: "sonar.exclusions"}); err == nil {
+		t.Errorf("Expected error for missing value\n")
+	}
+
+	s := Setting{Key: "sonar.exclusions", Value: "a", Values: []string{"b"}}
+	if _, err := c.SetSetting(projectKey, s); err == nil {
+		t.Errorf("Expected error for value and values\n")
+	}
+}
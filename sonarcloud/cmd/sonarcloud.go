@@ -6,6 +6,23 @@
 //		tokens
 //		metrics
 //		quality gates
+//		security hotspots
+//		rules
+//		quality gate management
+//		quality profiles
+//		webhooks
+//		webhook receiver
+//		compute engine tasks
+//		project analyses
+//		organizations
+//		permissions and user groups
+//		settings
+//		new code periods
+//		project tags and links
+//		sources and duplications
+//		ALM bindings
+//		notifications and favorites
+//		badge rendering
 package sonarcloud
 
 import (
@@ -60,6 +77,9 @@ type ComponentsObject struct {
 
 	// Revision hash
 	Revision string `json:"revision"`
+
+	// Visibility private or public
+	Visibility string `json:"visibility,omitempty"`
 }
 
 // NewProject Used to create a new project
@@ -117,6 +137,9 @@ type SonarCloudClient struct {
 
 	// connectino string
 	URI string
+
+	// badge tokens of private projects, created by New()
+	badgeTokens *badgeTokenCache
 }
 
 // NewTokenResponse holds response from user_tokens/generate
@@ -132,6 +155,31 @@ type NewTokenResponse struct {
 
 	// CreatedAt date and time of creation
 	CreatedAt string `json:"createdAt"`
+
+	// Type UserToken, ProjectAnalysisToken or GlobalAnalysisToken
+	Type string `json:"type,omitempty"`
+
+	// ExpirationDate date and time, empty if it never expires
+	ExpirationDate string `json:"expirationDate,omitempty"`
+}
+
+// NewToken used to create a token with CreateTokenWithOptions
+type NewToken struct {
+	// Name (required) of the token
+	Name string
+
+	// Login (optional) create the token for another user
+	Login string
+
+	// Type (optional) UserToken, ProjectAnalysisToken or
+	// GlobalAnalysisToken, defaults to UserToken
+	Type string
+
+	// ProjectKey (required) for a ProjectAnalysisToken
+	ProjectKey string
+
+	// ExpirationDate (optional) the token stops working after it
+	ExpirationDate time.Time
 }
 
 // GetTokenResponse user_tokens/search returns a user and
@@ -155,6 +203,45 @@ type GetTokenItem struct {
 	// LastConnectionDate date and time token was last used
 	// Only updated hourly
 	LastConnectionDate string `json:"lastConnectionDate"`
+
+	// Type UserToken, ProjectAnalysisToken or GlobalAnalysisToken
+	Type string `json:"type,omitempty"`
+
+	// ExpirationDate date and time, empty if it never expires
+	ExpirationDate string `json:"expirationDate,omitempty"`
+
+	// IsExpired true once ExpirationDate has passed
+	IsExpired bool `json:"isExpired,omitempty"`
+}
+
+// ExpiresAt return the expiration time
+//   ok is false if the token never expires
+func (t GetTokenItem) ExpiresAt() (expires time.Time, ok bool, err error) {
+	if t.ExpirationDate == "" {
+		return time.Time{}, false, nil
+	}
+
+	expires, err = time.Parse(DateTimeLayout, t.ExpirationDate)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	return expires, true, nil
+}
+
+// LastUsed return the last time the token was used
+//   ok is false if the token was never used
+func (t GetTokenItem) LastUsed() (used time.Time, ok bool, err error) {
+	if t.LastConnectionDate == "" {
+		return time.Time{}, false, nil
+	}
+
+	used, err = time.Parse(DateTimeLayout, t.LastConnectionDate)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	return used, true, nil
 }
 
 // SonarCloudError
@@ -196,6 +283,8 @@ func (c *SonarCloudClient) New(token string, timeoutSeconds int) error {
 	// https://token@host
 	c.URI = fmt.Sprintf("%s%s@%s", DefaultScheme, c.Token, c.Host)
 
+	c.badgeTokens = newBadgeTokenCache()
+
 	return nil
 }
 
@@ -298,63 +387,48 @@ func (c *SonarCloudClient) DeleteProject(p string) (*http.Response, error) {
 //   Create a new SonarCloud token with the name tn
 //   Note SonarCloud expects application/x-www-form-urlencoded
 func (c *SonarCloudClient) CreateToken(tn string) (*http.Response, error) {
+	return c.CreateTokenWithOptions(NewToken{Name: tn})
+}
 
-	data := url.Values{}
-	data.Set("name", tn)
-
-	url := c.URI + TokenCreate
-	req, err := http.NewRequest("POST", url, strings.NewReader(data.Encode()))
-	if err != nil {
-		return HandleHTTPClientError(nil, err)
+// CreateTokenWithOptions create a new SonarCloud token
+//   example:  CreateTokenWithOptions(NewToken{Name: tn,
+//		Type: ProjectAnalysisToken, ProjectKey: key,
+//		ExpirationDate: time.Now().AddDate(0, 3, 0)})
+//   Unmarshal the response into a NewTokenResponse
+//   A status code >= 400 returns the body as the error
+func (c *SonarCloudClient) CreateTokenWithOptions(t NewToken) (*http.Response, error) {
+	switch t.Type {
+	case "", UserToken, GlobalAnalysisToken:
+	case ProjectAnalysisToken:
+		if t.ProjectKey == "" {
+			return nil, errors.New("project key is required for " + ProjectAnalysisToken)
+		}
+	default:
+		return nil, errors.New("invalid token type: " + t.Type)
 	}
 
-	req.Header.Add(contentType, wwwForm)
-	req.Header.Add(contentLength, strconv.Itoa(len(data.Encode())))
-	rsp, err := c.Client.Do(req)
-
-	if err != nil {
-		return HandleHTTPClientError(rsp, err)
+	data := url.Values{}
+	data.Set("name", t.Name)
+	setIfNotEmpty(data, "login", t.Login)
+	setIfNotEmpty(data, "type", t.Type)
+	setIfNotEmpty(data, "projectKey", t.ProjectKey)
+	if !t.ExpirationDate.IsZero() {
+		data.Set("expirationDate", t.ExpirationDate.Format(DateLayout))
 	}
 
-	// return errmsg in the body as the error
-	if rsp.StatusCode == 400 {
-		errmsg, _ := ioutil.ReadAll(rsp.Body)
-		return rsp, errors.New(string(errmsg))
-	}
-	return rsp, nil
+	return c.post(TokenCreate, data)
 }
 
 // RevokeToken revoke the given token
 //   example: RevokeToken(tn string)
 //   Revoke a SonarCloud token with the name tn
+//   A status code >= 400 returns the body as the error
 func (c *SonarCloudClient) RevokeToken(tn string) (*http.Response, error) {
 
 	data := url.Values{}
 	data.Set("name", tn)
 
-	url := c.URI + TokenRevoke
-	req, err := http.NewRequest("POST", url, strings.NewReader(data.Encode()))
-	if err != nil {
-		return HandleHTTPClientError(nil, err)
-	}
-
-	req.Header.Add(contentType, wwwForm)
-	req.Header.Add(contentLength, strconv.Itoa(len(data.Encode())))
-	rsp, err := c.Client.Do(req)
-
-	// There was a problem with the connection
-	if err != nil {
-		return HandleHTTPClientError(rsp, err)
-	}
-
-	// There was a problem with the payload
-	// return errmsg in the body as the error
-	if rsp.StatusCode == 400 {
-		errmsg, _ := ioutil.ReadAll(rsp.Body)
-		return rsp, errors.New(string(errmsg))
-	}
-
-	return rsp, nil
+	return c.post(TokenRevoke, data)
 }
 
 // GetTokens get a list of tokens
@@ -387,13 +461,71 @@ func (c *SonarCloudClient) GetTokens(name string) (*http.Response, error) {
 }
 
 // NameToEnum return integer value of enum or -1 if not found
+//   Deprecated: use ParseMetric which returns an error
 func (c *SonarCloudClient) NameToEnum(name string) (enum int) {
-	for i, v := range MetricName {
-		if v == strings.ToLower(name) {
-			return i
-		}
+	m, err := ParseMetric(name)
+	if err != nil {
+		return -1
 	}
-	return -1
+	return int(m)
+}
+
+// BadgeOptions select what a badge reports on
+type BadgeOptions struct {
+	// Project (required) project to produce badge for
+	Project string
+
+	// Branch (optional) a long living branch
+	Branch string
+
+	// PullRequest (optional) pull request id, can't be used with Branch
+	PullRequest string
+}
+
+// badgeQuery return the badge query string for o
+//   The badge token is added for private projects
+func (c *SonarCloudClient) badgeQuery(o BadgeOptions) (string, error) {
+	if o.Project == "" {
+		return "", errors.New("project is required")
+	}
+
+	if o.Branch != "" && o.PullRequest != "" {
+		return "", errors.New("branch and pull request can't be used together")
+	}
+
+	options := fmt.Sprintf(Project, url.QueryEscape(o.Project))
+	if o.Branch != "" {
+		options += fmt.Sprintf("&"+Branch, url.QueryEscape(o.Branch))
+	}
+	if o.PullRequest != "" {
+		options += fmt.Sprintf("&"+PullRequest, url.QueryEscape(o.PullRequest))
+	}
+
+	token, err := c.BadgeToken(o.Project)
+	if err != nil {
+		return "", err
+	}
+	if token != "" {
+		options += fmt.Sprintf("&"+Token, url.QueryEscape(token))
+	}
+
+	return options, nil
+}
+
+// getBadge GET a badge from uri
+func (c *SonarCloudClient) getBadge(uri string) (*http.Response, error) {
+	req, err := http.NewRequest("GET", c.URI+uri, nil)
+	if err != nil {
+		return HandleHTTPClientError(nil, err)
+	}
+
+	resp, err := c.Client.Do(req)
+
+	if err != nil {
+		return HandleHTTPClientError(resp, err)
+	}
+
+	return resp, nil
 }
 
 // GetMetric return a badge for a given metric
@@ -415,53 +547,50 @@ func (c *SonarCloudClient) NameToEnum(name string) (enum int) {
 //  project  (required) project to produce bade for
 //  branch (optional) a long living branch
 //
-func (c *SonarCloudClient) GetMetric(metric int, project, branch string) (*http.Response, error) {
-	var url string
-	options := "?"
-	options += fmt.Sprintf(Metric, MetricName[metric])
-	options += fmt.Sprintf("&"+Project, project)
-	if branch != "" {
-		options += fmt.Sprintf("&"+Branch, branch)
-	}
-	url = c.URI + BadgeMetric + options
+//  The badge token is added for private projects
+//  An invalid metric returns an error
+//
+func (c *SonarCloudClient) GetMetric(metric Metric, project, branch string) (*http.Response, error) {
+	return c.GetMetricWithOptions(metric, BadgeOptions{Project: project, Branch: branch})
+}
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return HandleHTTPClientError(nil, err)
+// GetMetricWithOptions return a metric badge for a branch or pull request
+//   example: GetMetricWithOptions(Coverage, BadgeOptions{Project: projectKey,
+//		PullRequest: "42"})
+func (c *SonarCloudClient) GetMetricWithOptions(metric Metric, o BadgeOptions) (*http.Response, error) {
+	if !metric.Valid() {
+		return nil, fmt.Errorf("invalid metric %v", metric)
 	}
 
-	resp, err := c.Client.Do(req)
-
+	options, err := c.badgeQuery(o)
 	if err != nil {
-		return HandleHTTPClientError(resp, err)
+		return nil, err
 	}
 
-	return resp, nil
+	return c.getBadge(BadgeMetric + "?" + fmt.Sprintf(MetricParam, metric) + "&" + options)
 }
 
 // GetQualityGate return badge for a SonarCloud quality gate
 // example: GetQualityGate(project string) (*http.Response, error)
 // Return an SVG badge for inclusion in HTML
 // 	project (required) is a valid project name
+//  The badge token is added for private projects
 //
 func (c *SonarCloudClient) GetQualityGate(project string) (*http.Response, error) {
-	var url string
-	options := "?"
-	options += fmt.Sprintf(Project, project)
-	url = c.URI + QualityGate + options
-
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return HandleHTTPClientError(nil, err)
-	}
-
-	resp, err := c.Client.Do(req)
+	return c.GetQualityGateWithOptions(BadgeOptions{Project: project})
+}
 
+// GetQualityGateWithOptions return a quality gate badge for a branch
+// or pull request
+//   example: GetQualityGateWithOptions(BadgeOptions{Project: projectKey,
+//		Branch: "release-1.2"})
+func (c *SonarCloudClient) GetQualityGateWithOptions(o BadgeOptions) (*http.Response, error) {
+	options, err := c.badgeQuery(o)
 	if err != nil {
-		return HandleHTTPClientError(resp, err)
+		return nil, err
 	}
 
-	return resp, nil
+	return c.getBadge(QualityGate + "?" + options)
 }
 
 // HandleHTTPClientError returns (*http.Response, error)
@@ -7,38 +7,84 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/pavedroad-io/integrations/sonarcloud/sonarcloudtest"
 )
 
 var testClient SonarCloudClient
 var fakeClient SonarCloudClient
 var badServer http.Server
 
+// sonarServer fake SonarCloud used when SONARCLOUD_TOKEN isn't set,
+// the default offline path
+var sonarServer *sonarcloudtest.Server
+
+// recorder records testClient calls with SONARCLOUD_RECORD set and
+// replays them with SONARCLOUD_REPLAY set
+var recorder *sonarcloudtest.Recorder
+
+// fixtures written by SONARCLOUD_RECORD, none are committed
+const fixtures = "testdata/sonarcloud.json"
+
 func TestMain(t *testing.T) {
 	var token string
+	var err error
 	// Get token so we can run tests
 	envVar := os.Getenv("SONARCLOUD_TOKEN")
 	if envVar != "" {
 		token = envVar
+		if os.Getenv("SONARCLOUD_RECORD") != "" {
+			log.Println("Recording SonarCloud calls to " + fixtures)
+			if err = os.MkdirAll(filepath.Dir(fixtures), 0755); err != nil {
+				t.Fatalf(testErrorMsg, err)
+			}
+			if recorder, err = sonarcloudtest.NewRecorder(fixtures, sonarcloudtest.Record); err != nil {
+				t.Fatalf(testErrorMsg, err)
+			}
+		}
+	} else if os.Getenv("SONARCLOUD_REPLAY") != "" {
+		log.Println("SONARCLOUD_TOKEN not set, replaying " + fixtures)
+		if recorder, err = sonarcloudtest.NewRecorder(fixtures, sonarcloudtest.Replay); err != nil {
+			t.Fatalf(testErrorMsg, err)
+		}
+		token = "fake"
 	} else {
-		log.Println("Need SONARCLOUD_TOKEN set to run tests")
-		os.Exit(-1)
+		log.Println("SONARCLOUD_TOKEN not set, testing against a fake SonarCloud")
+		sonarServer = sonarcloudtest.NewServer()
+		sonarServer.AddProject(sonarcloudtest.Project{
+			Organization: orgname,
+			Key:          projectKey,
+			Name:         projectName,
+		})
+		token = "fake"
 	}
 
 	// Setup the client
 	testClient = SonarCloudClient{}
+	if sonarServer != nil {
+		testClient.Host = sonarServer.Host()
+	}
 
 	// client for talking to fake server listening on badServerAddress
 	fakeClient = SonarCloudClient{
 		Host: badServerAddress,
 	}
 
-	err := testClient.New(token, 10)
+	err = testClient.New(token, 10)
 	if err != nil {
 		t.Errorf(testErrorMsg, err)
 	}
+	if sonarServer != nil {
+		testClient.Client = sonarServer.Client()
+	}
+	if recorder != nil {
+		testClient.Client.Transport = recorder
+	}
 
 	err = fakeClient.New(token, 1)
 	if err != nil {
@@ -266,7 +312,7 @@ func TestGetToken(t *testing.T) {
 //
 func TestGetBadgeMetric(t *testing.T) {
 
-	metricList := []int{
+	metricList := []Metric{
 		Bugs,
 		CodeSmells,
 		Coverage,
@@ -324,6 +370,42 @@ func TestGetQualityGate(t *testing.T) {
 	}
 }
 
+// TestGetBadgeWithOptions badges for branches and pull requests
+func TestGetBadgeWithOptions(t *testing.T) {
+	var queries []url.Values
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == ComponentShow {
+			w.Write([]byte(`{"component": {"key": "test123", "visibility": "public"}}`))
+			return
+		}
+		queries = append(queries, r.URL.Query())
+		w.Write([]byte("<svg></svg>"))
+	})
+	defer srv.Close()
+
+	rsp, err := c.GetQualityGateWithOptions(BadgeOptions{Project: projectKey, Branch: "release/1.2"})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	checkResponseCode(t, http.StatusOK, rsp.StatusCode)
+
+	rsp, err = c.GetMetricWithOptions(Coverage, BadgeOptions{Project: projectKey, PullRequest: "42"})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	checkResponseCode(t, http.StatusOK, rsp.StatusCode)
+
+	if len(queries) != 2 || queries[0].Get("branch") != "release/1.2" ||
+		queries[1].Get("pullRequest") != "42" || queries[1].Get("metric") != "coverage" {
+		t.Errorf("Unexpected queries %v\n", queries)
+	}
+
+	_, err = c.GetQualityGateWithOptions(BadgeOptions{Project: projectKey, Branch: "main", PullRequest: "42"})
+	if err == nil {
+		t.Errorf("Expected error for branch and pull request\n")
+	}
+}
+
 // TestRevokeToken make sure we can delete a project
 func TestRevokeToken(t *testing.T) {
 	CreateTokenIfItDoesntExists(t)
@@ -0,0 +1,179 @@
+package sonarcloud
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// SourceOptions select a file and the branch or pull request to read
+//   Component is required
+type SourceOptions struct {
+	// Component file key e.g. PavedRoad_test123:main.go
+	Component string
+
+	// Branch (optional) a long living branch
+	Branch string
+
+	// PullRequest (optional) pull request id
+	PullRequest string
+
+	// From (optional) first line, 1-based
+	From int
+
+	// To (optional) last line, inclusive
+	To int
+}
+
+// SourceLinesResponse for a GET / sources/lines
+type SourceLinesResponse struct {
+	Sources []SourceLine `json:"sources"`
+}
+
+// SourceLine a line of source with its annotations
+type SourceLine struct {
+	// Line number, 1-based
+	Line int `json:"line"`
+
+	// Code HTML highlighted source
+	Code string `json:"code"`
+
+	// SCMRevision that last changed the line
+	SCMRevision string `json:"scmRevision,omitempty"`
+
+	// SCMAuthor that last changed the line
+	SCMAuthor string `json:"scmAuthor,omitempty"`
+
+	// SCMDate date and time the line was last changed
+	SCMDate string `json:"scmDate,omitempty"`
+
+	// LineHits times the line was executed by tests,
+	// nil if the line is not executable
+	LineHits *int `json:"lineHits,omitempty"`
+
+	// Conditions number of branches on the line
+	Conditions int `json:"conditions,omitempty"`
+
+	// CoveredConditions number of branches covered by tests
+	CoveredConditions int `json:"coveredConditions,omitempty"`
+
+	// Duplicated true if the line is part of a duplicated block
+	Duplicated bool `json:"duplicated"`
+
+	// IsNew true if the line is new code
+	IsNew bool `json:"isNew"`
+}
+
+// Coverable return true if tests can cover the line
+func (l SourceLine) Coverable() bool {
+	return l.LineHits != nil
+}
+
+// Covered return true if the line and all its conditions are covered
+func (l SourceLine) Covered() bool {
+	return l.LineHits != nil && *l.LineHits > 0 &&
+		l.CoveredConditions >= l.Conditions
+}
+
+// DuplicationResponse for a GET / duplications/show
+type DuplicationResponse struct {
+	// Duplications groups of duplicated blocks
+	Duplications []Duplication `json:"duplications"`
+
+	// Files referenced by the blocks, keyed by Ref
+	Files map[string]DuplicationFile `json:"files"`
+}
+
+// Duplication blocks that are copies of each other
+type Duplication struct {
+	Blocks []DuplicationBlock `json:"blocks"`
+}
+
+// DuplicationBlock a range of duplicated lines
+type DuplicationBlock struct {
+	// From first line, 1-based
+	From int `json:"from"`
+
+	// Size number of lines
+	Size int `json:"size"`
+
+	// Ref key into DuplicationResponse.Files
+	Ref string `json:"_ref"`
+}
+
+// DuplicationFile a file referenced by a duplication block
+type DuplicationFile struct {
+	// Key of the file component
+	Key string `json:"key"`
+
+	// Name for display
+	Name string `json:"name"`
+
+	// ProjectName project the file belongs to
+	ProjectName string `json:"projectName,omitempty"`
+}
+
+// File return the file a block refers to
+func (d DuplicationResponse) File(b DuplicationBlock) (DuplicationFile, bool) {
+	f, ok := d.Files[b.Ref]
+	return f, ok
+}
+
+// GetSourceLines read the lines of a file with coverage, SCM and
+// duplication annotations
+//   example: GetSourceLines(SourceOptions{Component: fileKey, From: 1, To: 100})
+//   Unmarshal the response into a SourceLinesResponse
+func (c *SonarCloudClient) GetSourceLines(o SourceOptions) (*http.Response, error) {
+	params, err := sourceValues(o)
+	if err != nil {
+		return nil, err
+	}
+
+	setIfNotZero(params, "from", o.From)
+	setIfNotZero(params, "to", o.To)
+
+	return c.get(SourceLines, params)
+}
+
+// GetSourceRaw read a file as plain text
+//   example: GetSourceRaw(SourceOptions{Component: fileKey})
+//   From and To are ignored
+func (c *SonarCloudClient) GetSourceRaw(o SourceOptions) (*http.Response, error) {
+	params, err := sourceValues(o)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.get(SourceRaw, params)
+}
+
+// GetDuplications read the duplicated blocks of a file
+//   example: GetDuplications(SourceOptions{Component: fileKey})
+//   From and To are ignored
+//   Unmarshal the response into a DuplicationResponse
+func (c *SonarCloudClient) GetDuplications(o SourceOptions) (*http.Response, error) {
+	params, err := sourceValues(o)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.get(DuplicationShow, params)
+}
+
+// sourceValues parameters common to source and duplication calls
+func sourceValues(o SourceOptions) (url.Values, error) {
+	if o.Component == "" {
+		return nil, errors.New("component is required")
+	}
+
+	if o.Branch != "" && o.PullRequest != "" {
+		return nil, errors.New("branch and pull request are mutually exclusive")
+	}
+
+	v := url.Values{}
+	v.Set("key", o.Component)
+	setIfNotEmpty(v, "branch", o.Branch)
+	setIfNotEmpty(v, "pullRequest", o.PullRequest)
+
+	return v, nil
+}
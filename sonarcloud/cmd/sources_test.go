@@ -0,0 +1,92 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+)
+
+const fileKey = "PavedRoad_test123:main.go"
+
+const sourceLinesJSON = `{
+  "sources": [
+    {"line": 1, "code": "<span class=\"k\">package</span> main", "scmAuthor": "dev@acme.io",
+     "scmDate": "2020-08-01T10:00:00+0000", "scmRevision": "c739069", "duplicated": false, "isNew": false},
+    {"line": 2, "code": "if a &amp;&amp; b {", "lineHits": 3, "conditions": 4, "coveredConditions": 2,
+     "duplicated": true, "isNew": true},
+    {"line": 3, "code": "return", "lineHits": 0, "duplicated": false, "isNew": true}
+  ]
+}`
+
+const duplicationJSON = `{
+  "duplications": [
+    {"blocks": [{"from": 2, "size": 10, "_ref": "1"}, {"from": 40, "size": 10, "_ref": "2"}]}
+  ],
+  "files": {
+    "1": {"key": "PavedRoad_test123:main.go", "name": "main.go", "projectName": "Test project 123"},
+    "2": {"key": "PavedRoad_test123:util.go", "name": "util.go", "projectName": "Test project 123"}
+  }
+}`
+
+// TestGetSourceLines
+func TestGetSourceLines(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("key") != fileKey || q.Get("from") != "1" || q.Get("to") != "3" {
+			t.Errorf("Unexpected query %v\n", q)
+		}
+		w.Write([]byte(sourceLinesJSON))
+	})
+	defer srv.Close()
+
+	rsp, err := c.GetSourceLines(SourceOptions{Component: fileKey, From: 1, To: 3})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var sl SourceLinesResponse
+	if err = decodeResponse(rsp, &sl); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	if len(sl.Sources) != 3 {
+		t.Fatalf(testErrorMsgValue, 3, len(sl.Sources))
+	}
+
+	l := sl.Sources
+	if l[0].Coverable() || l[0].SCMAuthor != "dev@acme.io" {
+		t.Errorf("Unexpected line %v\n", l[0])
+	}
+	if !l[1].Coverable() || l[1].Covered() || !l[1].Duplicated {
+		t.Errorf("Unexpected line %v\n", l[1])
+	}
+	if !l[2].Coverable() || l[2].Covered() {
+		t.Errorf("Unexpected line %v\n", l[2])
+	}
+
+	if _, err = c.GetSourceLines(SourceOptions{}); err == nil {
+		t.Errorf("Expected error for missing component\n")
+	}
+}
+
+// TestGetDuplications
+func TestGetDuplications(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(duplicationJSON))
+	})
+	defer srv.Close()
+
+	rsp, err := c.GetDuplications(SourceOptions{Component: fileKey})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var d DuplicationResponse
+	if err = decodeResponse(rsp, &d); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	f, ok := d.File(d.Duplications[0].Blocks[1])
+	if !ok || f.Name != "util.go" {
+		t.Errorf("Unexpected file %v\n", f)
+	}
+}
@@ -0,0 +1,114 @@
+package sonarcloud
+
+import (
+	"fmt"
+	"time"
+)
+
+// Reasons a token is reported by FindStaleTokens
+const (
+	TokenUnused    = "unused"
+	TokenNeverUsed = "never used"
+	TokenExpiring  = "expiring"
+	TokenExpired   = "expired"
+)
+
+// timeNow used by FindStaleTokens, replaced in tests
+var timeNow = time.Now
+
+// StaleToken a token reported by FindStaleTokens
+type StaleToken struct {
+	// Login the token belongs to
+	Login string
+
+	// Token as returned by GetTokens
+	Token GetTokenItem
+
+	// Reason TokenUnused, TokenNeverUsed, TokenExpiring or TokenExpired
+	Reason string
+}
+
+// FindStaleTokens report tokens of logins that should be revoked
+// or rotated
+//   example: FindStaleTokens([]string{"ci-bot", "release-bot"}, 90, 14)
+//
+//   A token is reported if
+//		it has expired or expires within expiringDays
+//		it was last used more than unusedDays ago
+//		it was never used and was created more than unusedDays ago
+//   An empty login checks the tokens of the current user
+func (c *SonarCloudClient) FindStaleTokens(logins []string, unusedDays, expiringDays int) ([]StaleToken, error) {
+	var stale []StaleToken
+
+	now := timeNow()
+	unusedSince := now.AddDate(0, 0, -unusedDays)
+	expiringBy := now.AddDate(0, 0, expiringDays)
+
+	for _, login := range logins {
+		rsp, err := c.GetTokens(login)
+		if err != nil {
+			return nil, err
+		}
+
+		var tr GetTokenResponse
+		if err = decodeResponse(rsp, &tr); err != nil {
+			return nil, fmt.Errorf("tokens of %s: %v", login, err)
+		}
+
+		for _, t := range tr.Tokens {
+			reason, err := tokenStaleReason(t, now, unusedSince, expiringBy)
+			if err != nil {
+				return nil, fmt.Errorf("token %s of %s: %v", t.Name, login, err)
+			}
+
+			if reason != "" {
+				stale = append(stale, StaleToken{
+					Login:  tr.Login,
+					Token:  t,
+					Reason: reason,
+				})
+			}
+		}
+	}
+
+	return stale, nil
+}
+
+// tokenStaleReason return why t is stale or "" if it isn't
+func tokenStaleReason(t GetTokenItem, now, unusedSince, expiringBy time.Time) (string, error) {
+	expires, ok, err := t.ExpiresAt()
+	if err != nil {
+		return "", err
+	}
+
+	if t.IsExpired || (ok && !expires.After(now)) {
+		return TokenExpired, nil
+	}
+
+	if ok && expires.Before(expiringBy) {
+		return TokenExpiring, nil
+	}
+
+	used, ok, err := t.LastUsed()
+	if err != nil {
+		return "", err
+	}
+
+	if ok {
+		if used.Before(unusedSince) {
+			return TokenUnused, nil
+		}
+		return "", nil
+	}
+
+	created, err := time.Parse(DateTimeLayout, t.CreatedAt)
+	if err != nil {
+		return "", err
+	}
+
+	if created.Before(unusedSince) {
+		return TokenNeverUsed, nil
+	}
+
+	return "", nil
+}
@@ -0,0 +1,100 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+const tokenSearchJSON = `{
+  "login": "ci-bot",
+  "userTokens": [
+    {"name": "fresh", "createdAt": "2020-07-01T10:00:00+0000",
+     "lastConnectionDate": "2020-07-30T10:00:00+0000"},
+    {"name": "idle", "createdAt": "2020-01-01T10:00:00+0000",
+     "lastConnectionDate": "2020-02-01T10:00:00+0000"},
+    {"name": "unused", "createdAt": "2020-01-01T10:00:00+0000"},
+    {"name": "new", "createdAt": "2020-07-31T10:00:00+0000"},
+    {"name": "expiring", "createdAt": "2020-07-01T10:00:00+0000",
+     "lastConnectionDate": "2020-07-30T10:00:00+0000",
+     "type": "GLOBAL_ANALYSIS_TOKEN",
+     "expirationDate": "2020-08-05T00:00:00+0000"},
+    {"name": "expired", "createdAt": "2020-01-01T10:00:00+0000",
+     "expirationDate": "2020-07-01T00:00:00+0000", "isExpired": true}
+  ]
+}`
+
+// TestFindStaleTokens
+func TestFindStaleTokens(t *testing.T) {
+	saved := timeNow
+	timeNow = func() time.Time {
+		return time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC)
+	}
+	defer func() { timeNow = saved }()
+
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("login") != "ci-bot" {
+			t.Errorf("Unexpected query %v\n", r.URL.Query())
+		}
+		w.Write([]byte(tokenSearchJSON))
+	})
+	defer srv.Close()
+
+	stale, err := c.FindStaleTokens([]string{"ci-bot"}, 90, 14)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	expected := map[string]string{
+		"idle":     TokenUnused,
+		"unused":   TokenNeverUsed,
+		"expiring": TokenExpiring,
+		"expired":  TokenExpired,
+	}
+
+	if len(stale) != len(expected) {
+		t.Errorf("Unexpected stale tokens %v\n", stale)
+	}
+
+	for _, s := range stale {
+		if expected[s.Token.Name] != s.Reason || s.Login != "ci-bot" {
+			t.Errorf("Unexpected stale token %v\n", s)
+		}
+	}
+}
+
+// TestCreateTokenWithOptions
+func TestCreateTokenWithOptions(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("type") != ProjectAnalysisToken || r.FormValue("projectKey") != projectKey ||
+			r.FormValue("expirationDate") != "2020-11-01" {
+			t.Errorf("Unexpected form %v\n", r.Form)
+		}
+		w.Write([]byte(`{"login": "ci-bot", "name": "ci", "token": "abc",
+		  "type": "PROJECT_ANALYSIS_TOKEN", "expirationDate": "2020-11-01T00:00:00+0000"}`))
+	})
+	defer srv.Close()
+
+	rsp, err := c.CreateTokenWithOptions(NewToken{
+		Name:           "ci",
+		Type:           ProjectAnalysisToken,
+		ProjectKey:     projectKey,
+		ExpirationDate: time.Date(2020, 11, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var tk NewTokenResponse
+	if err = decodeResponse(rsp, &tk); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	if tk.Type != ProjectAnalysisToken || tk.ExpirationDate == "" {
+		t.Errorf("Unexpected token %v\n", tk)
+	}
+
+	if _, err = c.CreateTokenWithOptions(NewToken{Name: "ci", Type: ProjectAnalysisToken}); err == nil {
+		t.Errorf("Expected error for missing project key\n")
+	}
+}
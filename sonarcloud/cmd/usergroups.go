@@ -0,0 +1,148 @@
+package sonarcloud
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// UserGroupSearchResponse for a GET / search on user groups
+type UserGroupSearchResponse struct {
+	// Paging object
+	Paging PagingObject `json:"paging"`
+
+	// Groups list
+	Groups []UserGroupObject `json:"groups"`
+}
+
+// UserGroupResponse for create and update on user groups
+type UserGroupResponse struct {
+	Group UserGroupObject `json:"group"`
+}
+
+// UserGroupObject a group of users
+type UserGroupObject struct {
+	// ID for access this object
+	ID int64 `json:"id"`
+
+	// Organization name
+	Organization string `json:"organization,omitempty"`
+
+	// Name for display
+	Name string `json:"name"`
+
+	// Description for display
+	Description string `json:"description,omitempty"`
+
+	// MembersCount number of users in the group
+	MembersCount int `json:"membersCount"`
+
+	// Default true for the group new members are added to
+	Default bool `json:"default"`
+}
+
+// UserGroupUsersResponse for a GET / users on a user group
+type UserGroupUsersResponse struct {
+	// Paging object
+	Paging PagingObject `json:"paging"`
+
+	// Users list
+	Users []UserGroupMember `json:"users"`
+}
+
+// UserGroupMember a user of a group
+type UserGroupMember struct {
+	// Login of the user
+	Login string `json:"login"`
+
+	// Name for display
+	Name string `json:"name"`
+
+	// Selected true if the user belongs to the group
+	Selected bool `json:"selected"`
+}
+
+// SearchUserGroups search groups of an organization
+//   example: SearchUserGroups(org, query)
+//   query (optional) matches the group name
+//   Unmarshal the response into a UserGroupSearchResponse
+func (c *SonarCloudClient) SearchUserGroups(org, query string) (*http.Response, error) {
+	params := url.Values{}
+	params.Set("organization", org)
+	setIfNotEmpty(params, "q", query)
+
+	return c.get(UserGroupSearch, params)
+}
+
+// CreateUserGroup create a group
+//   example: CreateUserGroup(org, "payments", "Payments team")
+//   Unmarshal the response into a UserGroupResponse
+func (c *SonarCloudClient) CreateUserGroup(org, name, description string) (*http.Response, error) {
+	if org == "" || name == "" {
+		return nil, errors.New("organization and name are required")
+	}
+
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("name", name)
+	setIfNotEmpty(data, "description", description)
+
+	return c.post(UserGroupCreate, data)
+}
+
+// UpdateUserGroup rename group id or change its description
+//   example: UpdateUserGroup(id, name, description)
+//   Empty fields are left unchanged
+func (c *SonarCloudClient) UpdateUserGroup(id int64, name, description string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("id", strconv.FormatInt(id, 10))
+	setIfNotEmpty(data, "name", name)
+	setIfNotEmpty(data, "description", description)
+
+	return c.post(UserGroupUpdate, data)
+}
+
+// DeleteUserGroup delete group name
+//   example: DeleteUserGroup(org, "payments")
+func (c *SonarCloudClient) DeleteUserGroup(org, name string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("name", name)
+
+	return c.post(UserGroupDelete, data)
+}
+
+// AddUserToGroup add user login to group name
+//   example: AddUserToGroup(org, "payments", login)
+//   The user must be a member of the organization
+func (c *SonarCloudClient) AddUserToGroup(org, name, login string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("name", name)
+	data.Set("login", login)
+
+	return c.post(UserGroupAddUser, data)
+}
+
+// RemoveUserFromGroup remove user login from group name
+//   example: RemoveUserFromGroup(org, "payments", login)
+func (c *SonarCloudClient) RemoveUserFromGroup(org, name, login string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("organization", org)
+	data.Set("name", name)
+	data.Set("login", login)
+
+	return c.post(UserGroupRemoveUser, data)
+}
+
+// GetUserGroupUsers list users of group name
+//   example: GetUserGroupUsers(org, "payments")
+//   Unmarshal the response into a UserGroupUsersResponse
+func (c *SonarCloudClient) GetUserGroupUsers(org, name string) (*http.Response, error) {
+	params := url.Values{}
+	params.Set("organization", org)
+	params.Set("name", name)
+
+	return c.get(UserGroupUsers, params)
+}
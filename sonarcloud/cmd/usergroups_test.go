@@ -0,0 +1,36 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+)
+
+// TestCreateUserGroup
+func TestCreateUserGroup(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("organization") != orgname || r.FormValue("name") != "payments" {
+			t.Errorf("Unexpected form %v\n", r.Form)
+		}
+		w.Write([]byte(`{"group": {"id": 42, "organization": "acme-demo", "name": "payments",
+		  "description": "Payments team", "membersCount": 0, "default": false}}`))
+	})
+	defer srv.Close()
+
+	rsp, err := c.CreateUserGroup(orgname, "payments", "Payments team")
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var g UserGroupResponse
+	if err = decodeResponse(rsp, &g); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	if g.Group.ID != 42 || g.Group.Description != "Payments team" {
+		t.Errorf("Unexpected group %v\n", g.Group)
+	}
+
+	if _, err = c.CreateUserGroup(orgname, "", ""); err == nil {
+		t.Errorf("Expected error for missing name\n")
+	}
+}
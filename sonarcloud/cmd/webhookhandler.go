@@ -0,0 +1,317 @@
+package sonarcloud
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Webhook receiver defaults
+const (
+	// DefaultReplayWindow how long a delivered payload is remembered
+	DefaultReplayWindow = 24 * time.Hour
+
+	// maxWebhookBody largest payload accepted
+	maxWebhookBody = 1 << 20
+)
+
+// WebhookPayload sent by SonarCloud when an analysis completes
+type WebhookPayload struct {
+	// ServerURL of the SonarCloud instance
+	ServerURL string `json:"serverUrl"`
+
+	// TaskID of the Compute Engine task
+	TaskID string `json:"taskId"`
+
+	// Status SUCCESS, FAILED or CANCELED
+	Status string `json:"status"`
+
+	// AnalysedAt date and time
+	AnalysedAt string `json:"analysedAt"`
+
+	// ChangedAt date and time
+	ChangedAt string `json:"changedAt"`
+
+	// Revision hash
+	Revision string `json:"revision"`
+
+	// Project that was analyzed
+	Project WebhookProject `json:"project"`
+
+	// Branch or pull request that was analyzed
+	Branch WebhookBranch `json:"branch"`
+
+	// QualityGate result, empty if the task failed
+	QualityGate WebhookQualityGate `json:"qualityGate"`
+
+	// Properties passed to the scanner as sonar.analysis.*
+	Properties map[string]string `json:"properties"`
+}
+
+// WebhookProject project in a webhook payload
+type WebhookProject struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// WebhookBranch branch or pull request in a webhook payload
+type WebhookBranch struct {
+	// Name of the branch or pull request id
+	Name string `json:"name"`
+
+	// Type BRANCH or PULL_REQUEST
+	Type string `json:"type"`
+
+	// IsMain true for the main branch
+	IsMain bool `json:"isMain"`
+
+	// URL of the branch dashboard
+	URL string `json:"url"`
+}
+
+// WebhookQualityGate quality gate result in a webhook payload
+type WebhookQualityGate struct {
+	// Name of the gate
+	Name string `json:"name"`
+
+	// Status GateOK or GateError
+	Status string `json:"status"`
+
+	// Conditions evaluated
+	Conditions []WebhookCondition `json:"conditions"`
+}
+
+// WebhookCondition result of a single condition
+type WebhookCondition struct {
+	// Metric key e.g. new_coverage
+	Metric string `json:"metric"`
+
+	// Operator LESS_THAN or GREATER_THAN
+	Operator string `json:"operator"`
+
+	// Value measured, missing when there is no value
+	Value string `json:"value"`
+
+	// Status OK, ERROR or NO_VALUE
+	Status string `json:"status"`
+
+	// ErrorThreshold value that fails the condition
+	ErrorThreshold string `json:"errorThreshold"`
+}
+
+// AnalysedTime parse AnalysedAt
+func (p WebhookPayload) AnalysedTime() (time.Time, error) {
+	return time.Parse(DateTimeLayout, p.AnalysedAt)
+}
+
+// ChangedTime parse ChangedAt
+func (p WebhookPayload) ChangedTime() (time.Time, error) {
+	return time.Parse(DateTimeLayout, p.ChangedAt)
+}
+
+// sentTime return the latest of ChangedAt and AnalysedAt
+func (p WebhookPayload) sentTime() (time.Time, error) {
+	at, err := p.AnalysedTime()
+	changed, cerr := p.ChangedTime()
+	if cerr == nil && (err != nil || changed.After(at)) {
+		return changed, nil
+	}
+	return at, err
+}
+
+// WebhookCallback called for each verified payload
+//   Returning an error makes the handler answer 500 so
+//   SonarCloud records a failed delivery
+type WebhookCallback func(p WebhookPayload) error
+
+// WebhookHandler an http.Handler receiving SonarCloud webhook calls
+//   Create it with NewWebhookHandler, the zero value with Secret set
+//   works the same
+//
+//   Requests are rejected with
+//		405 if the method is not POST
+//		401 if the signature is missing or does not match Secret
+//		400 if the payload can't be decoded
+//		409 if the same payload was already delivered or its
+//		    analysedAt and changedAt are older than ReplayWindow
+//		500 if a callback returns an error
+//
+//   Delivered payloads are remembered in memory for ReplayWindow and
+//   older payloads are rejected, so after a restart a payload can only
+//   be replayed until it is ReplayWindow old
+type WebhookHandler struct {
+	// Secret configured on the webhook, required
+	Secret string
+
+	// ReplayWindow how long a payload is remembered to reject replays,
+	// zero means DefaultReplayWindow
+	ReplayWindow time.Duration
+
+	mu        sync.Mutex
+	callbacks []WebhookCallback
+	seen      map[string]time.Time
+	now       func() time.Time
+}
+
+// NewWebhookHandler create a handler verifying payloads with secret
+func NewWebhookHandler(secret string) *WebhookHandler {
+	return &WebhookHandler{
+		Secret:       secret,
+		ReplayWindow: DefaultReplayWindow,
+		seen:         make(map[string]time.Time),
+		now:          time.Now,
+	}
+}
+
+// Handle register f to be called for every verified payload
+//   Callbacks are called in the order they were registered
+func (h *WebhookHandler) Handle(f WebhookCallback) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.callbacks = append(h.callbacks, f)
+}
+
+// ServeHTTP verify, decode and dispatch a webhook call
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+	if err != nil {
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+
+	// hex is case insensitive, normalize it for replay detection
+	signature := strings.ToLower(r.Header.Get(WebhookSignatureHeader))
+	if err = VerifyWebhookSignature(h.Secret, signature, body); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var p WebhookPayload
+	if err = json.Unmarshal(body, &p); err != nil {
+		http.Error(w, "malformed payload", http.StatusBadRequest)
+		return
+	}
+
+	if p.TaskID == "" || p.Project.Key == "" {
+		http.Error(w, "payload missing task or project", http.StatusBadRequest)
+		return
+	}
+
+	at, err := p.sentTime()
+	if err != nil {
+		http.Error(w, "payload missing analysedAt", http.StatusBadRequest)
+		return
+	}
+
+	if h.clock().Sub(at) > h.window() {
+		http.Error(w, "payload is too old", http.StatusConflict)
+		return
+	}
+
+	if h.replayed(signature) {
+		http.Error(w, "payload already delivered", http.StatusConflict)
+		return
+	}
+
+	h.mu.Lock()
+	callbacks := make([]WebhookCallback, len(h.callbacks))
+	copy(callbacks, h.callbacks)
+	h.mu.Unlock()
+
+	for _, f := range callbacks {
+		if err = f(p); err != nil {
+			// Let the payload be delivered again
+			h.mu.Lock()
+			delete(h.seen, signature)
+			h.mu.Unlock()
+
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// replayed record signature and report if it was seen within
+// ReplayWindow, expired entries are dropped
+func (h *WebhookHandler) replayed(signature string) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.seen == nil {
+		h.seen = make(map[string]time.Time)
+	}
+
+	now := h.clock()
+	for k, at := range h.seen {
+		if now.Sub(at) > h.window() {
+			delete(h.seen, k)
+		}
+	}
+
+	if _, ok := h.seen[signature]; ok {
+		return true
+	}
+
+	h.seen[signature] = now
+
+	return false
+}
+
+// clock return the current time, time.Now unless a test replaced it
+func (h *WebhookHandler) clock() time.Time {
+	if h.now == nil {
+		return time.Now()
+	}
+	return h.now()
+}
+
+// window return ReplayWindow or DefaultReplayWindow if it isn't set
+func (h *WebhookHandler) window() time.Duration {
+	if h.ReplayWindow <= 0 {
+		return DefaultReplayWindow
+	}
+	return h.ReplayWindow
+}
+
+// VerifyWebhookSignature check signature is the hex encoded
+// HMAC-SHA256 of body using secret
+func VerifyWebhookSignature(secret, signature string, body []byte) error {
+	if secret == "" {
+		return errors.New("webhook secret is not configured")
+	}
+
+	if signature == "" {
+		return errors.New("missing " + WebhookSignatureHeader)
+	}
+
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return errors.New("invalid signature")
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
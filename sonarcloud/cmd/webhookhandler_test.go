@@ -0,0 +1,205 @@
+package sonarcloud
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const webhookSecret = "s3cret"
+
+const webhookPayloadJSON = `{
+  "serverUrl": "https://sonarcloud.io",
+  "taskId": "AXT1",
+  "status": "SUCCESS",
+  "analysedAt": "2020-08-01T10:46:28+0100",
+  "revision": "c739069ec7105e01303e8b3065a81141aad9f129",
+  "changedAt": "2020-08-01T10:46:28+0100",
+  "project": {
+    "key": "PavedRoad_test123",
+    "name": "Test project 123",
+    "url": "https://sonarcloud.io/dashboard?id=PavedRoad_test123"
+  },
+  "branch": {
+    "name": "main",
+    "type": "BRANCH",
+    "isMain": true,
+    "url": "https://sonarcloud.io/dashboard?id=PavedRoad_test123"
+  },
+  "qualityGate": {
+    "name": "Sonar way",
+    "status": "ERROR",
+    "conditions": [
+      {
+        "metric": "new_coverage",
+        "operator": "LESS_THAN",
+        "value": "62.5",
+        "status": "ERROR",
+        "errorThreshold": "80"
+      }
+    ]
+  },
+  "properties": {}
+}`
+
+// noTimeJSON a payload without analysedAt or changedAt
+const noTimeJSON = `{"taskId": "AXT2", "project": {"key": "PavedRoad_test123"}}`
+
+// sign return the signature SonarCloud sends for body
+func sign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// deliver post body to h with signature
+func deliver(h http.Handler, method, body, signature string) int {
+	req := httptest.NewRequest(method, "/sonarcloud", strings.NewReader(body))
+	if signature != "" {
+		req.Header.Set(WebhookSignatureHeader, signature)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	return rec.Code
+}
+
+// TestWebhookHandler
+func TestWebhookHandler(t *testing.T) {
+	h := newPayloadHandler(t)
+
+	var got []WebhookPayload
+	h.Handle(func(p WebhookPayload) error {
+		got = append(got, p)
+		return nil
+	})
+
+	code := deliver(h, http.MethodPost, webhookPayloadJSON, sign(webhookSecret, webhookPayloadJSON))
+	checkResponseCode(t, http.StatusOK, code)
+
+	if len(got) != 1 {
+		t.Fatalf(testErrorMsgValue, 1, len(got))
+	}
+
+	p := got[0]
+	if p.Project.Key != "PavedRoad_test123" || !p.Branch.IsMain ||
+		p.QualityGate.Conditions[0].ErrorThreshold != "80" {
+		t.Errorf("Unexpected payload %v\n", p)
+	}
+
+	at, err := p.AnalysedTime()
+	if err != nil || at.UTC().Hour() != 9 {
+		t.Errorf("Unexpected analysedAt %v %v\n", at, err)
+	}
+
+	// The same delivery again is a replay
+	code = deliver(h, http.MethodPost, webhookPayloadJSON, sign(webhookSecret, webhookPayloadJSON))
+	checkResponseCode(t, http.StatusConflict, code)
+
+	if len(got) != 1 {
+		t.Errorf(testErrorMsgValue, 1, len(got))
+	}
+}
+
+// TestWebhookHandlerRejects
+func TestWebhookHandlerRejects(t *testing.T) {
+	h := newPayloadHandler(t)
+	h.Handle(func(p WebhookPayload) error {
+		t.Errorf("Unexpected callback %v\n", p)
+		return nil
+	})
+
+	tests := []struct {
+		method    string
+		body      string
+		signature string
+		expected  int
+	}{
+		{http.MethodGet, "", "", http.StatusMethodNotAllowed},
+		{http.MethodPost, webhookPayloadJSON, "", http.StatusUnauthorized},
+		{http.MethodPost, webhookPayloadJSON, sign("wrong", webhookPayloadJSON), http.StatusUnauthorized},
+		{http.MethodPost, webhookPayloadJSON, "not-hex", http.StatusUnauthorized},
+		{http.MethodPost, "{", sign(webhookSecret, "{"), http.StatusBadRequest},
+		{http.MethodPost, "{}", sign(webhookSecret, "{}"), http.StatusBadRequest},
+		{http.MethodPost, noTimeJSON, sign(webhookSecret, noTimeJSON), http.StatusBadRequest},
+	}
+
+	for _, tc := range tests {
+		code := deliver(h, tc.method, tc.body, tc.signature)
+		checkResponseCode(t, tc.expected, code)
+	}
+}
+
+// TestWebhookHandlerCallbackError and replay window expiry
+func TestWebhookHandlerCallbackError(t *testing.T) {
+	h := newPayloadHandler(t)
+	now := h.now()
+	h.now = func() time.Time { return now }
+
+	fail := true
+	h.Handle(func(p WebhookPayload) error {
+		if fail {
+			return errors.New("pipeline unavailable")
+		}
+		return nil
+	})
+
+	signature := sign(webhookSecret, webhookPayloadJSON)
+	code := deliver(h, http.MethodPost, webhookPayloadJSON, signature)
+	checkResponseCode(t, http.StatusInternalServerError, code)
+
+	// A failed delivery can be retried
+	fail = false
+	code = deliver(h, http.MethodPost, webhookPayloadJSON, signature)
+	checkResponseCode(t, http.StatusOK, code)
+
+	code = deliver(h, http.MethodPost, webhookPayloadJSON, signature)
+	checkResponseCode(t, http.StatusConflict, code)
+
+	// Once the replay window has passed the payload is too old
+	now = now.Add(DefaultReplayWindow + time.Minute)
+	code = deliver(h, http.MethodPost, webhookPayloadJSON, signature)
+	checkResponseCode(t, http.StatusConflict, code)
+
+	// and so it is for a handler that never saw it
+	h = NewWebhookHandler(webhookSecret)
+	h.now = func() time.Time { return now }
+	code = deliver(h, http.MethodPost, webhookPayloadJSON, signature)
+	checkResponseCode(t, http.StatusConflict, code)
+}
+
+// TestWebhookHandlerZeroValue a literal handler defaults its clock,
+// replay window and delivered payloads
+func TestWebhookHandlerZeroValue(t *testing.T) {
+	h := &WebhookHandler{Secret: webhookSecret}
+
+	now := time.Now().Format(DateTimeLayout)
+	payload := strings.Replace(webhookPayloadJSON, "2020-08-01T10:46:28+0100", now, -1)
+
+	code := deliver(h, http.MethodPost, payload, sign(webhookSecret, payload))
+	checkResponseCode(t, http.StatusOK, code)
+
+	code = deliver(h, http.MethodPost, payload, sign(webhookSecret, payload))
+	checkResponseCode(t, http.StatusConflict, code)
+}
+
+// newPayloadHandler a handler whose clock is just after webhookPayloadJSON
+// was sent
+func newPayloadHandler(t *testing.T) *WebhookHandler {
+	h := NewWebhookHandler(webhookSecret)
+
+	at, err := time.Parse(DateTimeLayout, "2020-08-01T10:47:00+0100")
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	h.now = func() time.Time { return at }
+
+	return h
+}
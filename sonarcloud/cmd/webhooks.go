@@ -0,0 +1,223 @@
+package sonarcloud
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// NewWebhook used to create or update a webhook
+//   Leave Project empty for an organization level webhook
+type NewWebhook struct {
+	// Organization (required) is a valid SonarCloud organization
+	Organization string
+
+	// Project (optional) is the SonarCloud Key
+	Project string
+
+	// Name (required) friendly name for display
+	Name string
+
+	// URL (required) called when an analysis completes
+	URL string
+
+	// Secret (optional) used to sign the payload
+	Secret string
+
+	// ClearSecret remove the secret on UpdateWebhook, can't be used
+	// with Secret
+	ClearSecret bool
+}
+
+// WebhookCreateResponse for a POST / create on webhooks
+type WebhookCreateResponse struct {
+	Webhook WebhookObject `json:"webhook"`
+}
+
+// WebhookListResponse for a GET / list on webhooks
+type WebhookListResponse struct {
+	Webhooks []WebhookObject `json:"webhooks"`
+}
+
+// WebhookObject a webhook
+type WebhookObject struct {
+	// Key for access this object
+	Key string `json:"key"`
+
+	// Name for display
+	Name string `json:"name"`
+
+	// URL called when an analysis completes
+	URL string `json:"url"`
+
+	// HasSecret true if payloads are signed
+	HasSecret bool `json:"hasSecret"`
+
+	// LatestDelivery last call made, if any
+	LatestDelivery *WebhookDeliveryObject `json:"latestDelivery,omitempty"`
+}
+
+// WebhookDeliveriesOptions used to filter webhook deliveries
+//   One of Webhook, Component or TaskID is required
+type WebhookDeliveriesOptions struct {
+	// Webhook key
+	Webhook string
+
+	// Component is the SonarCloud project Key
+	Component string
+
+	// TaskID of the Compute Engine task that triggered the call
+	TaskID string
+
+	// Page (optional) 1-based page number
+	Page int
+
+	// PageSize (optional) number of deliveries per page
+	PageSize int
+}
+
+// WebhookDeliveriesResponse for a GET / deliveries on webhooks
+type WebhookDeliveriesResponse struct {
+	// Paging object
+	Paging PagingObject `json:"paging"`
+
+	// Deliveries list
+	Deliveries []WebhookDeliveryObject `json:"deliveries"`
+}
+
+// WebhookDeliveryResponse for a GET / delivery on webhooks
+type WebhookDeliveryResponse struct {
+	Delivery WebhookDeliveryObject `json:"delivery"`
+}
+
+// WebhookDeliveryObject a single call made by a webhook
+type WebhookDeliveryObject struct {
+	// ID for access this object
+	ID string `json:"id"`
+
+	// ComponentKey project that was analyzed
+	ComponentKey string `json:"componentKey,omitempty"`
+
+	// TaskID of the Compute Engine task that triggered the call
+	TaskID string `json:"ceTaskId,omitempty"`
+
+	// Name of the webhook
+	Name string `json:"name,omitempty"`
+
+	// URL that was called
+	URL string `json:"url,omitempty"`
+
+	// At date and time of the call
+	At string `json:"at"`
+
+	// Success true if the receiver returned a 2xx status
+	Success bool `json:"success"`
+
+	// HTTPStatus returned by the receiver, 0 if it was not reached
+	HTTPStatus int `json:"httpStatus"`
+
+	// DurationMs time taken by the call
+	DurationMs int `json:"durationMs"`
+
+	// Payload sent, only returned by GetWebhookDelivery
+	Payload string `json:"payload,omitempty"`
+}
+
+// Duration return the call duration
+func (d WebhookDeliveryObject) Duration() time.Duration {
+	return time.Duration(d.DurationMs) * time.Millisecond
+}
+
+// CreateWebhook create an organization or project webhook
+//   example: CreateWebhook(NewWebhook{Organization: org, Name: n, URL: u})
+//   Unmarshal the response into a WebhookCreateResponse
+func (c *SonarCloudClient) CreateWebhook(w NewWebhook) (*http.Response, error) {
+	if w.Organization == "" || w.Name == "" || w.URL == "" {
+		return nil, errors.New("organization, name and url are required")
+	}
+
+	data := url.Values{}
+	data.Set("organization", w.Organization)
+	data.Set("name", w.Name)
+	data.Set("url", w.URL)
+	setIfNotEmpty(data, "project", w.Project)
+	setIfNotEmpty(data, "secret", w.Secret)
+
+	return c.post(WebhookCreate, data)
+}
+
+// GetWebhooks list webhooks of an organization or project
+//   example: GetWebhooks(org, project)
+//   project (optional) list project webhooks instead
+//   Unmarshal the response into a WebhookListResponse
+func (c *SonarCloudClient) GetWebhooks(org, project string) (*http.Response, error) {
+	params := url.Values{}
+	params.Set("organization", org)
+	setIfNotEmpty(params, "project", project)
+
+	return c.get(WebhookList, params)
+}
+
+// UpdateWebhook update webhook key
+//   example: UpdateWebhook(key, NewWebhook{Name: n, URL: u, Secret: s})
+//   Organization and Project are ignored
+//   An empty Secret keeps the current secret, set ClearSecret to remove it
+func (c *SonarCloudClient) UpdateWebhook(key string, w NewWebhook) (*http.Response, error) {
+	if w.Name == "" || w.URL == "" {
+		return nil, errors.New("name and url are required")
+	}
+
+	if w.ClearSecret && w.Secret != "" {
+		return nil, errors.New("secret and clear secret can't be used together")
+	}
+
+	data := url.Values{}
+	data.Set("webhook", key)
+	data.Set("name", w.Name)
+	data.Set("url", w.URL)
+	if w.ClearSecret {
+		data.Set("secret", "")
+	} else {
+		setIfNotEmpty(data, "secret", w.Secret)
+	}
+
+	return c.post(WebhookUpdate, data)
+}
+
+// DeleteWebhook delete webhook key
+//   example: DeleteWebhook(key)
+func (c *SonarCloudClient) DeleteWebhook(key string) (*http.Response, error) {
+	data := url.Values{}
+	data.Set("webhook", key)
+
+	return c.post(WebhookDelete, data)
+}
+
+// GetWebhookDeliveries list recent calls made by webhooks
+//   example: GetWebhookDeliveries(WebhookDeliveriesOptions{Webhook: key})
+//   Unmarshal the response into a WebhookDeliveriesResponse
+func (c *SonarCloudClient) GetWebhookDeliveries(o WebhookDeliveriesOptions) (*http.Response, error) {
+	if o.Webhook == "" && o.Component == "" && o.TaskID == "" {
+		return nil, errors.New("webhook, component or task id is required")
+	}
+
+	params := url.Values{}
+	setIfNotEmpty(params, "webhook", o.Webhook)
+	setIfNotEmpty(params, "componentKey", o.Component)
+	setIfNotEmpty(params, "ceTaskId", o.TaskID)
+	setIfNotZero(params, "p", o.Page)
+	setIfNotZero(params, "ps", o.PageSize)
+
+	return c.get(WebhookDeliveries, params)
+}
+
+// GetWebhookDelivery read a single delivery including its payload
+//   example: GetWebhookDelivery(id)
+//   Unmarshal the response into a WebhookDeliveryResponse
+func (c *SonarCloudClient) GetWebhookDelivery(id string) (*http.Response, error) {
+	params := url.Values{}
+	params.Set("deliveryId", id)
+
+	return c.get(WebhookDelivery, params)
+}
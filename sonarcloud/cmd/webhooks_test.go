@@ -0,0 +1,138 @@
+package sonarcloud
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+const webhookDeliveriesJSON = `{
+  "paging": {"pageIndex": 1, "pageSize": 10, "total": 2},
+  "deliveries": [
+    {
+      "id": "d1",
+      "componentKey": "PavedRoad_test123",
+      "ceTaskId": "AXT1",
+      "name": "pipeline",
+      "url": "https://ci.acme.io/sonar",
+      "at": "2020-08-01T10:00:00+0000",
+      "success": false,
+      "httpStatus": 502,
+      "durationMs": 1250
+    },
+    {
+      "id": "d2",
+      "componentKey": "PavedRoad_test123",
+      "ceTaskId": "AXT0",
+      "name": "pipeline",
+      "url": "https://ci.acme.io/sonar",
+      "at": "2020-07-31T10:00:00+0000",
+      "success": true,
+      "httpStatus": 200,
+      "durationMs": 40
+    }
+  ]
+}`
+
+// TestCreateWebhook
+func TestCreateWebhook(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("project") != projectKey || r.FormValue("secret") != "s3cret" {
+			t.Errorf("Unexpected form %v\n", r.Form)
+		}
+		w.Write([]byte(`{"webhook": {"key": "wh1", "name": "pipeline", "url": "https://ci.acme.io/sonar"}}`))
+	})
+	defer srv.Close()
+
+	rsp, err := c.CreateWebhook(NewWebhook{
+		Organization: orgname,
+		Project:      projectKey,
+		Name:         "pipeline",
+		URL:          "https://ci.acme.io/sonar",
+		Secret:       "s3cret",
+	})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var wh WebhookCreateResponse
+	if err = decodeResponse(rsp, &wh); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	if wh.Webhook.Key != "wh1" {
+		t.Errorf(testErrorMsgValue, "wh1", wh.Webhook.Key)
+	}
+
+	if _, err = c.CreateWebhook(NewWebhook{Organization: orgname}); err == nil {
+		t.Errorf("Expected error for missing name and url\n")
+	}
+}
+
+// TestUpdateWebhookSecret keep or clear the secret
+func TestUpdateWebhookSecret(t *testing.T) {
+	var forms []map[string][]string
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		forms = append(forms, r.PostForm)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	defer srv.Close()
+
+	hook := NewWebhook{Name: "pipeline", URL: "https://ci.acme.io/sonar"}
+	if _, err := c.UpdateWebhook("AU-Tpxb", hook); err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	hook.ClearSecret = true
+	if _, err := c.UpdateWebhook("AU-Tpxb", hook); err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	// Omitted keeps the secret, empty removes it
+	if _, ok := forms[0]["secret"]; ok {
+		t.Errorf("Expected secret to be omitted %v\n", forms[0])
+	}
+	if v, ok := forms[1]["secret"]; !ok || v[0] != "" {
+		t.Errorf("Expected an empty secret %v\n", forms[1])
+	}
+
+	hook.Secret = "s3cret"
+	if _, err := c.UpdateWebhook("AU-Tpxb", hook); err == nil {
+		t.Errorf("Expected error for secret and clear secret\n")
+	}
+}
+
+// TestGetWebhookDeliveries
+func TestGetWebhookDeliveries(t *testing.T) {
+	c, srv := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("webhook") != "wh1" {
+			t.Errorf("Unexpected query %v\n", r.URL.Query())
+		}
+		w.Write([]byte(webhookDeliveriesJSON))
+	})
+	defer srv.Close()
+
+	rsp, err := c.GetWebhookDeliveries(WebhookDeliveriesOptions{Webhook: "wh1"})
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	var wd WebhookDeliveriesResponse
+	if err = decodeResponse(rsp, &wd); err != nil {
+		t.Fatalf(testMarshalFail, err)
+	}
+
+	if len(wd.Deliveries) != 2 {
+		t.Fatalf(testErrorMsgValue, 2, len(wd.Deliveries))
+	}
+
+	d := wd.Deliveries[0]
+	if d.Success || d.HTTPStatus != 502 || d.Duration() != 1250*time.Millisecond {
+		t.Errorf("Unexpected delivery %v\n", d)
+	}
+
+	if _, err = c.GetWebhookDeliveries(WebhookDeliveriesOptions{}); err == nil {
+		t.Errorf("Expected error for missing filter\n")
+	}
+}
@@ -0,0 +1,242 @@
+package sonarcloudtest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sync"
+)
+
+// Recorder modes
+const (
+	// Replay serve responses from the fixture file, no network
+	Replay = iota
+
+	// Record forward requests and save them to the fixture file
+	Record
+)
+
+// Redacted replaces secrets in fixtures
+const Redacted = "REDACTED"
+
+// scrubKeys query, form and JSON fields holding secrets
+var scrubKeys = []string{"token", "apiKey", "api_key", "secret"}
+
+// jsonSecret matches a JSON field holding a secret
+var jsonSecret = regexp.MustCompile(`("(?:token|apiKey|api_key|secret)"\s*:\s*)"[^"]*"`)
+
+// Interaction a request and the response it got
+type Interaction struct {
+	Method string `json:"method"`
+
+	// URL without user info and with secrets redacted
+	URL string `json:"url"`
+
+	// Body form encoded request body with secrets redacted
+	Body string `json:"body,omitempty"`
+
+	Status      int    `json:"status"`
+	ContentType string `json:"contentType,omitempty"`
+	Response    string `json:"response"`
+
+	used bool
+}
+
+// fixture file layout
+type fixture struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder an http.RoundTripper recording SonarCloud or FOSSA calls to
+// a fixture file and replaying them
+//   Use it as the Transport of the client under test
+type Recorder struct {
+	// Transport used in Record mode, defaults to http.DefaultTransport
+	Transport http.RoundTripper
+
+	mode         int
+	path         string
+	mu           sync.Mutex
+	interactions []*Interaction
+}
+
+// NewRecorder return a Recorder for the fixture file at path
+//   example: NewRecorder("testdata/sonarcloud.json", Replay)
+//   Record starts a new file, saved after every request
+//   Replay fails if the file can't be read
+func NewRecorder(path string, mode int) (*Recorder, error) {
+	r := &Recorder{mode: mode, path: path}
+
+	switch mode {
+	case Record:
+		return r, r.save()
+	case Replay:
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var f fixture
+		if err = json.Unmarshal(b, &f); err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		r.interactions = f.Interactions
+
+		return r, nil
+	}
+
+	return nil, fmt.Errorf("invalid recorder mode %d", mode)
+}
+
+// RoundTrip record or replay req
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		body = b
+		req.Body = ioutil.NopCloser(bytes.NewReader(b))
+	}
+
+	in := &Interaction{
+		Method: req.Method,
+		URL:    scrubURL(req.URL),
+		Body:   scrubForm(string(body)),
+	}
+
+	if r.mode == Record {
+		return r.record(req, in)
+	}
+
+	return r.replay(req, in)
+}
+
+func (r *Recorder) record(req *http.Request, in *Interaction) (*http.Response, error) {
+	t := r.Transport
+	if t == nil {
+		t = http.DefaultTransport
+	}
+
+	rsp, err := t.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := ioutil.ReadAll(rsp.Body)
+	rsp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rsp.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+	in.Status = rsp.StatusCode
+	in.ContentType = rsp.Header.Get("Content-Type")
+	in.Response = jsonSecret.ReplaceAllString(string(b), `${1}"`+Redacted+`"`)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.interactions = append(r.interactions, in)
+
+	return rsp, r.save()
+}
+
+// replay return the first unused interaction matching in so repeated
+// requests get their responses in recorded order
+func (r *Recorder) replay(req *http.Request, in *Interaction) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, v := range r.interactions {
+		if v.used || v.Method != in.Method || v.URL != in.URL || v.Body != in.Body {
+			continue
+		}
+		v.used = true
+
+		rsp := &http.Response{
+			Status:        fmt.Sprintf("%d %s", v.Status, http.StatusText(v.Status)),
+			StatusCode:    v.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        make(http.Header),
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(v.Response))),
+			ContentLength: int64(len(v.Response)),
+			Request:       req,
+		}
+		if v.ContentType != "" {
+			rsp.Header.Set("Content-Type", v.ContentType)
+		}
+
+		return rsp, nil
+	}
+
+	return nil, errors.New("no recorded response for " + in.Method + " " + in.URL)
+}
+
+// Unused return the recorded interactions that were not replayed
+func (r *Recorder) Unused() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unused []Interaction
+	for _, v := range r.interactions {
+		if !v.used {
+			unused = append(unused, *v)
+		}
+	}
+	return unused
+}
+
+func (r *Recorder) save() error {
+	b, err := json.MarshalIndent(fixture{Interactions: r.interactions}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(r.path, append(b, '\n'), 0644)
+}
+
+// scrubURL drop user info and redact secrets in the query
+func scrubURL(u *url.URL) string {
+	c := *u
+	c.User = nil
+
+	q := c.Query()
+	for _, k := range scrubKeys {
+		if q.Get(k) != "" {
+			q.Set(k, Redacted)
+		}
+	}
+	c.RawQuery = q.Encode()
+
+	return c.String()
+}
+
+// scrubForm redact secrets in a form encoded body
+func scrubForm(body string) string {
+	if body == "" {
+		return ""
+	}
+
+	v, err := url.ParseQuery(body)
+	if err != nil {
+		return body
+	}
+
+	for _, k := range scrubKeys {
+		if v.Get(k) != "" {
+			v.Set(k, Redacted)
+		}
+	}
+
+	return v.Encode()
+}
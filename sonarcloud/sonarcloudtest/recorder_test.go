@@ -0,0 +1,104 @@
+package sonarcloudtest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestRecorder record against the fake then replay without it
+func TestRecorder(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recorder")
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "sonarcloud.json")
+
+	srv := NewServer()
+	srv.AddProject(Project{Organization: "acme", Key: "billing", Visibility: "private", BadgeToken: "b1"})
+
+	rec, err := NewRecorder(path, Record)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	rec.Transport = srv.Client().Transport
+
+	base := "https://secret@" + srv.Host()
+	run := func(c *http.Client) []int {
+		var codes []int
+		for i := 0; i < 2; i++ {
+			rsp, err := c.PostForm(base+TokenCreate, url.Values{"name": {"ci"}})
+			if err != nil {
+				t.Fatalf(testErrorMsg, err)
+			}
+			rsp.Body.Close()
+			codes = append(codes, rsp.StatusCode)
+		}
+
+		rsp, err := c.Get(base + BadgeMetric + "?project=billing&metric=bugs&token=b1")
+		if err != nil {
+			t.Fatalf(testErrorMsg, err)
+		}
+		rsp.Body.Close()
+		return append(codes, rsp.StatusCode)
+	}
+
+	recorded := run(&http.Client{Transport: rec})
+	srv.Close()
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	tk, _ := srv.Token(DefaultLogin, "ci")
+	for _, secret := range []string{"secret", "b1", tk.Token} {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("Fixture contains secret %s\n", secret)
+		}
+	}
+
+	rec, err = NewRecorder(path, Replay)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	// The second create fails as the token already exists
+	expected := []int{http.StatusOK, http.StatusBadRequest, http.StatusOK}
+	replayed := run(&http.Client{Transport: rec})
+	for i := range expected {
+		if recorded[i] != expected[i] || replayed[i] != expected[i] {
+			t.Errorf("Expected %v Got %v and %v\n", expected, recorded, replayed)
+		}
+	}
+
+	if len(rec.Unused()) != 0 {
+		t.Errorf("Unexpected unused interactions %v\n", rec.Unused())
+	}
+
+	if _, err = (&http.Client{Transport: rec}).Get(base + ProjectSearch); err == nil {
+		t.Errorf("Expected error for a request that wasn't recorded\n")
+	}
+}
+
+// TestScrub webhook secrets are redacted like tokens
+func TestScrub(t *testing.T) {
+	form := scrubForm("name=ci&secret=s1&url=https%3A%2F%2Fci")
+	if strings.Contains(form, "s1") || !strings.Contains(form, "secret="+Redacted) {
+		t.Errorf("Unexpected form %s\n", form)
+	}
+
+	u, _ := url.Parse("https://user@sonarcloud.io/api/webhooks/create?secret=s1&token=t1")
+	if got := scrubURL(u); strings.Contains(got, "s1") || strings.Contains(got, "t1") || strings.Contains(got, "user") {
+		t.Errorf("Unexpected URL %s\n", got)
+	}
+
+	body := jsonSecret.ReplaceAllString(`{"webhook": {"key": "k", "secret": "s1"}}`, `${1}"`+Redacted+`"`)
+	if body != `{"webhook": {"key": "k", "secret": "`+Redacted+`"}}` {
+		t.Errorf("Unexpected body %s\n", body)
+	}
+}
@@ -0,0 +1,505 @@
+// Package sonarcloudtest provides an in-memory SonarCloud server for tests
+//
+//   Support is limited to:
+//		projects search, create and delete
+//		user tokens generate, search and revoke
+//		project badges and badge tokens
+//
+//   Recorder replays fixtures recorded from SonarCloud or FOSSA instead
+//
+//   Point a client at it with:
+//		srv := sonarcloudtest.NewServer()
+//		defer srv.Close()
+//		c := sonarcloud.SonarCloudClient{Host: srv.Host()}
+//		c.New("token", 10)
+//		c.Client = srv.Client()
+package sonarcloudtest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// API paths served by the fake
+const (
+	ProjectSearch    = "/api/projects/search"
+	ProjectCreate    = "/api/projects/create"
+	ProjectDelete    = "/api/projects/delete"
+	TokenSearch      = "/api/user_tokens/search"
+	TokenCreate      = "/api/user_tokens/generate"
+	TokenRevoke      = "/api/user_tokens/revoke"
+	ComponentShow    = "/api/components/show"
+	BadgeMetric      = "/api/project_badges/measure"
+	BadgeQualityGate = "/api/project_badges/quality_gate"
+	BadgeToken       = "/api/project_badges/token"
+)
+
+// DefaultLogin owner of the tokens created without a login
+const DefaultLogin = "pavedroad"
+
+// dateTimeLayout used by SonarCloud for createdAt and similar fields
+const dateTimeLayout = "2006-01-02T15:04:05-0700"
+
+// metrics accepted by the badge endpoints
+var metrics = map[string]string{
+	"alert_status":             "OK",
+	"bugs":                     "0",
+	"code_smells":              "12",
+	"coverage":                 "81.5%",
+	"duplicated_lines_density": "1.2%",
+	"ncloc":                    "4.2k",
+	"reliability_rating":       "A",
+	"security_rating":          "A",
+	"sqale_index":              "2h",
+	"sqale_rating":             "A",
+	"vulnerabilities":          "0",
+}
+
+// Project held by the fake
+type Project struct {
+	Organization string
+	Key          string
+	Name         string
+
+	// Visibility public or private, defaults to public
+	Visibility string
+
+	// BadgeToken needed by badges of private projects, generated if empty
+	BadgeToken string
+}
+
+// Token held by the fake
+type Token struct {
+	Login          string
+	Name           string
+	Token          string
+	Type           string
+	ProjectKey     string
+	CreatedAt      string
+	ExpirationDate string
+}
+
+// apiError a forced failure
+type apiError struct {
+	status int
+	msg    string
+}
+
+// Server a fake SonarCloud API
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	projects map[string]*Project
+	tokens   map[tokenKey]*Token
+	failures map[string]apiError
+}
+
+// tokenKey token names are unique per login
+type tokenKey struct {
+	login string
+	name  string
+}
+
+// NewServer start a TLS fake SonarCloud with no projects or tokens
+//   Call Close when done
+func NewServer() *Server {
+	s := &Server{
+		projects: make(map[string]*Project),
+		tokens:   make(map[tokenKey]*Token),
+		failures: make(map[string]apiError),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(ProjectSearch, s.auth(http.MethodGet, s.searchProjects))
+	mux.HandleFunc(ProjectCreate, s.auth(http.MethodPost, s.createProject))
+	mux.HandleFunc(ProjectDelete, s.auth(http.MethodPost, s.deleteProject))
+	mux.HandleFunc(TokenSearch, s.auth(http.MethodGet, s.searchTokens))
+	mux.HandleFunc(TokenCreate, s.auth(http.MethodPost, s.createToken))
+	mux.HandleFunc(TokenRevoke, s.auth(http.MethodPost, s.revokeToken))
+	mux.HandleFunc(ComponentShow, s.auth(http.MethodGet, s.showComponent))
+	mux.HandleFunc(BadgeToken, s.auth(http.MethodGet, s.badgeToken))
+	mux.HandleFunc(BadgeMetric, s.badge)
+	mux.HandleFunc(BadgeQualityGate, s.badge)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "Unknown url : "+r.URL.Path)
+	})
+
+	s.Server = httptest.NewTLSServer(mux)
+
+	return s
+}
+
+// Host return the host:port to use as SonarCloudClient.Host
+func (s *Server) Host() string {
+	return s.Listener.Addr().String()
+}
+
+// AddProject store p as if it had been created
+func (s *Server) AddProject(p Project) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.addProject(p)
+}
+
+// Project return a copy of the project with key
+func (s *Server) Project(key string) (Project, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p, ok := s.projects[key]
+	if !ok {
+		return Project{}, false
+	}
+	return *p, true
+}
+
+// Token return a copy of the token of login with name
+func (s *Server) Token(login, name string) (Token, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t, ok := s.tokens[tokenKey{login, name}]
+	if !ok {
+		return Token{}, false
+	}
+	return *t, true
+}
+
+// FailNext make the next request to path fail with status and msg
+//   example: FailNext(ProjectCreate, http.StatusInternalServerError, "boom")
+func (s *Server) FailNext(path string, status int, msg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures[path] = apiError{status: status, msg: msg}
+}
+
+func (s *Server) addProject(p Project) *Project {
+	if p.Visibility == "" {
+		p.Visibility = "public"
+	}
+	if p.BadgeToken == "" {
+		p.BadgeToken = randomToken()
+	}
+
+	s.projects[p.Key] = &p
+
+	return &p
+}
+
+// auth reject requests without a token, with the wrong method or
+// with a forced failure, then lock the server and call next
+func (s *Server) auth(method string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			writeError(w, http.StatusMethodNotAllowed, "HTTP method "+r.Method+" is not supported")
+			return
+		}
+
+		if user, _, ok := r.BasicAuth(); !ok || user == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if f, ok := s.failures[r.URL.Path]; ok {
+			delete(s.failures, r.URL.Path)
+			writeError(w, f.status, f.msg)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+func (s *Server) searchProjects(w http.ResponseWriter, r *http.Request) {
+	org := r.FormValue("organization")
+	if org == "" {
+		writeError(w, http.StatusBadRequest, "The 'organization' parameter is missing")
+		return
+	}
+
+	var keys []string
+	if v := r.FormValue("projects"); v != "" {
+		keys = strings.Split(v, ",")
+	} else {
+		for k := range s.projects {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	components := []map[string]string{}
+	for _, k := range keys {
+		p, ok := s.projects[k]
+		if !ok || p.Organization != org {
+			continue
+		}
+		components = append(components, map[string]string{
+			"organization": p.Organization,
+			"key":          p.Key,
+			"name":         p.Name,
+			"qualifier":    "TRK",
+			"visibility":   p.Visibility,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"paging": map[string]int{
+			"pageIndex": 1,
+			"pageSize":  100,
+			"total":     len(components),
+		},
+		"components": components,
+	})
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	for _, f := range []string{"name", "project", "organization"} {
+		if r.FormValue(f) == "" {
+			writeError(w, http.StatusBadRequest, "The '"+f+"' parameter is missing")
+			return
+		}
+	}
+
+	key := r.FormValue("project")
+	if _, ok := s.projects[key]; ok {
+		writeError(w, http.StatusBadRequest, "Could not create Project, key already exists: "+key)
+		return
+	}
+
+	visibility := r.FormValue("visibility")
+	if visibility != "" && visibility != "public" && visibility != "private" {
+		writeError(w, http.StatusBadRequest,
+			"Value of parameter 'visibility' ("+visibility+") must be one of: [private, public]")
+		return
+	}
+
+	p := s.addProject(Project{
+		Organization: r.FormValue("organization"),
+		Key:          key,
+		Name:         r.FormValue("name"),
+		Visibility:   visibility,
+	})
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"project": map[string]string{
+			"key":        p.Key,
+			"name":       p.Name,
+			"qualifier":  "TRK",
+			"visibility": p.Visibility,
+		},
+	})
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	key := r.FormValue("project")
+	if _, ok := s.projects[key]; !ok {
+		writeError(w, http.StatusNotFound, "Component key '"+key+"' not found")
+		return
+	}
+
+	delete(s.projects, key)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) createToken(w http.ResponseWriter, r *http.Request) {
+	name := r.FormValue("name")
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "The 'name' parameter is missing")
+		return
+	}
+
+	login := r.FormValue("login")
+	if login == "" {
+		login = DefaultLogin
+	}
+
+	key := tokenKey{login, name}
+	if _, ok := s.tokens[key]; ok {
+		writeError(w, http.StatusBadRequest,
+			fmt.Sprintf("A user token for login '%s' and name '%s' already exists", login, name))
+		return
+	}
+
+	t := &Token{
+		Login:          login,
+		Name:           name,
+		Token:          randomToken(),
+		Type:           r.FormValue("type"),
+		ProjectKey:     r.FormValue("projectKey"),
+		CreatedAt:      time.Now().Format(dateTimeLayout),
+		ExpirationDate: r.FormValue("expirationDate"),
+	}
+	if t.Type == "" {
+		t.Type = "USER_TOKEN"
+	}
+	s.tokens[key] = t
+
+	writeJSON(w, http.StatusOK, map[string]string{
+		"login":          t.Login,
+		"name":           t.Name,
+		"token":          t.Token,
+		"createdAt":      t.CreatedAt,
+		"type":           t.Type,
+		"expirationDate": t.ExpirationDate,
+	})
+}
+
+func (s *Server) searchTokens(w http.ResponseWriter, r *http.Request) {
+	login := r.FormValue("login")
+	if login == "" {
+		login = DefaultLogin
+	}
+
+	var names []string
+	for k := range s.tokens {
+		if k.login == login {
+			names = append(names, k.name)
+		}
+	}
+	sort.Strings(names)
+
+	tokens := []map[string]string{}
+	for _, n := range names {
+		t := s.tokens[tokenKey{login, n}]
+		tokens = append(tokens, map[string]string{
+			"name":           t.Name,
+			"createdAt":      t.CreatedAt,
+			"type":           t.Type,
+			"expirationDate": t.ExpirationDate,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"login":      login,
+		"userTokens": tokens,
+	})
+}
+
+func (s *Server) revokeToken(w http.ResponseWriter, r *http.Request) {
+	login := r.FormValue("login")
+	if login == "" {
+		login = DefaultLogin
+	}
+
+	name := r.FormValue("name")
+	key := tokenKey{login, name}
+	if _, ok := s.tokens[key]; !ok {
+		writeError(w, http.StatusNotFound, "User token with name '"+name+"' doesn't exist")
+		return
+	}
+
+	delete(s.tokens, key)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) showComponent(w http.ResponseWriter, r *http.Request) {
+	key := r.FormValue("component")
+	p, ok := s.projects[key]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Component key '"+key+"' not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"component": map[string]string{
+			"organization": p.Organization,
+			"key":          p.Key,
+			"name":         p.Name,
+			"qualifier":    "TRK",
+			"visibility":   p.Visibility,
+		},
+	})
+}
+
+func (s *Server) badgeToken(w http.ResponseWriter, r *http.Request) {
+	key := r.FormValue("project")
+	p, ok := s.projects[key]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Project '"+key+"' not found")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]string{"token": p.BadgeToken})
+}
+
+// badge serve measure and quality_gate badges, no login needed
+func (s *Server) badge(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if f, ok := s.failures[r.URL.Path]; ok {
+		delete(s.failures, r.URL.Path)
+		writeSVG(w, f.status, f.msg)
+		return
+	}
+
+	p, ok := s.projects[r.FormValue("project")]
+	if !ok {
+		writeSVG(w, http.StatusNotFound, "Project has not been found")
+		return
+	}
+
+	if p.Visibility == "private" && r.FormValue("token") != p.BadgeToken {
+		writeSVG(w, http.StatusForbidden, "Project is invalid")
+		return
+	}
+
+	if r.FormValue("branch") != "" && r.FormValue("pullRequest") != "" {
+		writeSVG(w, http.StatusBadRequest, "Either branch or pull request can be provided, not both")
+		return
+	}
+
+	if r.URL.Path == BadgeQualityGate {
+		writeSVG(w, http.StatusOK, "passed")
+		return
+	}
+
+	value, ok := metrics[r.FormValue("metric")]
+	if !ok {
+		writeSVG(w, http.StatusNotFound, "Metric has not been found")
+		return
+	}
+
+	writeSVG(w, http.StatusOK, value)
+}
+
+// writeError write a SonarCloud error payload
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errors": []map[string]string{{"msg": msg}},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// writeSVG badges report errors as images too
+func writeSVG(w http.ResponseWriter, status int, text string) {
+	w.Header().Set("Content-Type", "image/svg+xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" height="20"><text x="5" y="14">%s</text></svg>`, html.EscapeString(text))
+}
+
+func randomToken() string {
+	b := make([]byte, 20)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,221 @@
+package sonarcloudtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+const testErrorMsg = "Expected err to be nil Got %v\n"
+
+// call send a request to srv as user token
+func call(t *testing.T, srv *Server, method, path string, v url.Values) *http.Response {
+	u := "https://token@" + srv.Host() + path
+	var rsp *http.Response
+	var err error
+
+	if method == http.MethodPost {
+		rsp, err = srv.Client().PostForm(u, v)
+	} else {
+		rsp, err = srv.Client().Get(u + "?" + v.Encode())
+	}
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+
+	return rsp
+}
+
+// errorMsg read the first message of a SonarCloud error payload
+func errorMsg(t *testing.T, rsp *http.Response) string {
+	defer rsp.Body.Close()
+
+	var e struct {
+		Errors []struct {
+			Msg string `json:"msg"`
+		} `json:"errors"`
+	}
+	if err := json.NewDecoder(rsp.Body).Decode(&e); err != nil || len(e.Errors) == 0 {
+		t.Fatalf("Expected an error payload Got %v\n", err)
+	}
+
+	return e.Errors[0].Msg
+}
+
+// TestProjects create, duplicate, search and delete
+func TestProjects(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	p := url.Values{"name": {"Payments"}, "project": {"acme_payments"}, "organization": {"acme"}}
+
+	rsp := call(t, srv, http.MethodPost, ProjectCreate, p)
+	rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected 200 Got %d\n", rsp.StatusCode)
+	}
+
+	rsp = call(t, srv, http.MethodPost, ProjectCreate, p)
+	if rsp.StatusCode != http.StatusBadRequest || !strings.Contains(errorMsg(t, rsp), "key already exists") {
+		t.Errorf("Expected duplicate key error\n")
+	}
+
+	rsp = call(t, srv, http.MethodGet, ProjectSearch, url.Values{"organization": {"acme"}})
+	var search struct {
+		Components []struct {
+			Key string `json:"key"`
+		} `json:"components"`
+	}
+	json.NewDecoder(rsp.Body).Decode(&search)
+	rsp.Body.Close()
+	if len(search.Components) != 1 || search.Components[0].Key != "acme_payments" {
+		t.Errorf("Unexpected search %v\n", search)
+	}
+
+	rsp = call(t, srv, http.MethodPost, ProjectDelete, url.Values{"project": {"acme_payments"}})
+	rsp.Body.Close()
+	if _, ok := srv.Project("acme_payments"); ok || rsp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected project to be deleted\n")
+	}
+
+	rsp = call(t, srv, http.MethodGet, ProjectCreate, p)
+	rsp.Body.Close()
+	if rsp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("Expected 405 Got %d\n", rsp.StatusCode)
+	}
+}
+
+// TestTokens generate, search and revoke
+func TestTokens(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	rsp := call(t, srv, http.MethodPost, TokenCreate, url.Values{"name": {"ci"}})
+	rsp.Body.Close()
+
+	tk, ok := srv.Token(DefaultLogin, "ci")
+	if !ok || tk.Token == "" || tk.Type != "USER_TOKEN" || tk.Login != DefaultLogin {
+		t.Errorf("Unexpected token %v\n", tk)
+	}
+
+	rsp = call(t, srv, http.MethodGet, TokenSearch, url.Values{})
+	var search struct {
+		UserTokens []struct {
+			Name string `json:"name"`
+		} `json:"userTokens"`
+	}
+	json.NewDecoder(rsp.Body).Decode(&search)
+	rsp.Body.Close()
+	if len(search.UserTokens) != 1 || search.UserTokens[0].Name != "ci" {
+		t.Errorf("Unexpected search %v\n", search)
+	}
+
+	rsp = call(t, srv, http.MethodPost, TokenRevoke, url.Values{"name": {"ci"}})
+	rsp.Body.Close()
+	if _, ok = srv.Token(DefaultLogin, "ci"); ok || rsp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected token to be revoked\n")
+	}
+
+	rsp = call(t, srv, http.MethodPost, TokenRevoke, url.Values{"name": {"ci"}})
+	if rsp.StatusCode != http.StatusNotFound || errorMsg(t, rsp) == "" {
+		t.Errorf("Expected 404 Got %d\n", rsp.StatusCode)
+	}
+}
+
+// TestTokensPerLogin token names are only unique per login
+func TestTokensPerLogin(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	for _, login := range []string{DefaultLogin, "bot"} {
+		rsp := call(t, srv, http.MethodPost, TokenCreate, url.Values{"name": {"ci"}, "login": {login}})
+		rsp.Body.Close()
+		if rsp.StatusCode != http.StatusOK {
+			t.Errorf("Expected 200 for %s Got %d\n", login, rsp.StatusCode)
+		}
+	}
+
+	rsp := call(t, srv, http.MethodPost, TokenRevoke, url.Values{"name": {"ci"}, "login": {"bot"}})
+	rsp.Body.Close()
+	if _, ok := srv.Token("bot", "ci"); ok {
+		t.Errorf("Expected bot token to be revoked\n")
+	}
+	if _, ok := srv.Token(DefaultLogin, "ci"); !ok {
+		t.Errorf("Expected %s token to be kept\n", DefaultLogin)
+	}
+
+	rsp = call(t, srv, http.MethodGet, TokenSearch, url.Values{"login": {"bot"}})
+	var search struct {
+		UserTokens []struct {
+			Name string `json:"name"`
+		} `json:"userTokens"`
+	}
+	json.NewDecoder(rsp.Body).Decode(&search)
+	rsp.Body.Close()
+	if len(search.UserTokens) != 0 {
+		t.Errorf("Unexpected search %v\n", search)
+	}
+}
+
+// TestBadges private projects need their badge token
+func TestBadges(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.AddProject(Project{Organization: "acme", Key: "billing", Visibility: "private", BadgeToken: "b1"})
+
+	cases := []struct {
+		path   string
+		v      url.Values
+		status int
+	}{
+		{BadgeMetric, url.Values{"project": {"billing"}, "metric": {"bugs"}}, http.StatusForbidden},
+		{BadgeMetric, url.Values{"project": {"billing"}, "metric": {"bugs"}, "token": {"b1"}}, http.StatusOK},
+		{BadgeMetric, url.Values{"project": {"billing"}, "metric": {"speed"}, "token": {"b1"}}, http.StatusNotFound},
+		{BadgeQualityGate, url.Values{"project": {"billing"}, "token": {"b1"}}, http.StatusOK},
+		{BadgeQualityGate, url.Values{"project": {"payments"}}, http.StatusNotFound},
+	}
+
+	for _, c := range cases {
+		rsp, err := srv.Client().Get(srv.URL + c.path + "?" + c.v.Encode())
+		if err != nil {
+			t.Fatalf(testErrorMsg, err)
+		}
+		rsp.Body.Close()
+
+		if rsp.StatusCode != c.status || rsp.Header.Get("Content-Type") != "image/svg+xml" {
+			t.Errorf("%s %v expected %d Got %d\n", c.path, c.v, c.status, rsp.StatusCode)
+		}
+	}
+}
+
+// TestFailNext a forced failure is returned once
+func TestFailNext(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	srv.FailNext(TokenSearch, http.StatusInternalServerError, "boom")
+
+	rsp := call(t, srv, http.MethodGet, TokenSearch, url.Values{})
+	if rsp.StatusCode != http.StatusInternalServerError || errorMsg(t, rsp) != "boom" {
+		t.Errorf("Expected forced failure\n")
+	}
+
+	rsp = call(t, srv, http.MethodGet, TokenSearch, url.Values{})
+	rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		t.Errorf("Expected 200 Got %d\n", rsp.StatusCode)
+	}
+
+	u := srv.URL + TokenSearch
+	rsp, err := srv.Client().Get(u)
+	if err != nil {
+		t.Fatalf(testErrorMsg, err)
+	}
+	rsp.Body.Close()
+	if rsp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Expected 401 without a token Got %d\n", rsp.StatusCode)
+	}
+}